@@ -17,17 +17,62 @@ data "victoriametricscloud_cloud_providers" "available" {}
 # Get list of available regions
 data "victoriametricscloud_regions" "available" {}
 
+# Get regions of a single cloud provider
+data "victoriametricscloud_regions" "aws" {
+  cloud_provider = "aws"
+}
+
 # Get list of available tiers
 data "victoriametricscloud_tiers" "available" {}
 
+# Get the cheapest single-node tier handling the expected load
+data "victoriametricscloud_tier_recommendation" "single" {
+  type               = "single_node"
+  ingestion_rate     = 50000
+  active_time_series = 1000000
+}
+
+# Compare monthly costs of candidate deployment shapes
+# (the storage price is derived from an existing deployment unless storage_price_per_gb_month is set)
+# data "victoriametricscloud_price_estimate" "candidates" {
+#   scenarios = [
+#     { tier = 1, storage_size = 100, storage_size_unit = "GB", type = "single_node" },
+#     { tier = 2, storage_size = 1, storage_size_unit = "TB", type = "single_node" },
+#   ]
+# }
+
 # Get list of all deployments
 data "victoriametricscloud_deployments" "all" {}
 
+# Get cluster deployments with names starting with "prod-"
+data "victoriametricscloud_deployments" "prod_clusters" {
+  name_regex = "^prod-"
+  type       = "cluster"
+}
+
 # Get details of a specific deployment (if you have one)
 # data "victoriametricscloud_deployment" "specific" {
 #   id = "your-deployment-id"
 # }
 
+# Look up a deployment by its unique name instead of its ID
+# data "victoriametricscloud_deployment" "prod_eu" {
+#   name = "prod-eu"
+# }
+
+# List rule files of a deployment, including ones not managed by Terraform
+# data "victoriametricscloud_rule_files" "alerts" {
+#   deployment_id   = "your-deployment-id"
+#   name_filter     = "alerts-*.yaml"
+#   include_content = true
+# }
+
+# Get the content of a single rule file
+# data "victoriametricscloud_rule_file" "specific" {
+#   deployment_id = "your-deployment-id"
+#   file_name     = "alerts.yaml"
+# }
+
 output "cloud_providers" {
   description = "Available cloud providers"
   value       = data.victoriametricscloud_cloud_providers.available.cloud_providers
@@ -43,6 +88,11 @@ output "tiers" {
   value       = data.victoriametricscloud_tiers.available.tiers
 }
 
+output "recommended_tier" {
+  description = "Cheapest tier satisfying the requirements"
+  value       = data.victoriametricscloud_tier_recommendation.single.tier.name
+}
+
 output "deployments" {
   description = "All deployments"
   value       = data.victoriametricscloud_deployments.all.deployments
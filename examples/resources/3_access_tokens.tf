@@ -36,3 +36,68 @@ output "agent_token" {
   value       = victoriametricscloud_access_token.single_agent_token.secret
   sensitive   = true
 }
+
+# Render vmagent flags for the write-only agent token
+data "victoriametricscloud_client_config" "single_agent" {
+  deployment_id = victoriametricscloud_deployment.single_demo.id
+  access_token  = victoriametricscloud_access_token.single_agent_token.secret
+  format        = "vmagent"
+}
+
+output "agent_flags" {
+  description = "vmagent command-line flags (sensitive)"
+  value       = data.victoriametricscloud_client_config.single_agent.content
+  sensitive   = true
+}
+
+# Check the data plane of the deployment with the read-only Grafana token
+data "victoriametricscloud_deployment_health" "single" {
+  deployment_id = victoriametricscloud_deployment.single_demo.id
+  access_token  = victoriametricscloud_access_token.single_grafana_token.secret
+  top_n         = 5
+}
+
+check "single_demo_ready" {
+  assert {
+    condition     = data.victoriametricscloud_deployment_health.single.ready
+    error_message = "Deployment is not ready: ${coalesce(data.victoriametricscloud_deployment_health.single.health_error, "unknown")}"
+  }
+}
+
+# Gate on data ingested by the agent
+data "victoriametricscloud_query" "api_up" {
+  deployment_id = victoriametricscloud_deployment.single_demo.id
+  access_token  = victoriametricscloud_access_token.single_grafana_token.secret
+  query         = "min(up{job=\"api\"})"
+}
+
+check "api_up" {
+  assert {
+    condition     = alltrue([for r in data.victoriametricscloud_query.api_up.results : r.samples[0].value == 1])
+    error_message = "Some api targets are down."
+  }
+}
+
+# Short-lived tokens for the smoke test, deleted at the end of the run and never stored in state
+ephemeral "victoriametricscloud_ephemeral_access_token" "smoke_write" {
+  deployment_id = victoriametricscloud_deployment.single_demo.id
+  type          = "w"
+  description   = "Smoke test writer"
+}
+
+ephemeral "victoriametricscloud_ephemeral_access_token" "smoke_read" {
+  deployment_id = victoriametricscloud_deployment.single_demo.id
+  type          = "r"
+  description   = "Smoke test reader"
+}
+
+# Prove the deployment accepts data end to end (Terraform 1.14+):
+#   terraform apply -invoke=action.victoriametricscloud_smoke_test.single_demo
+action "victoriametricscloud_smoke_test" "single_demo" {
+  config {
+    deployment_id = victoriametricscloud_deployment.single_demo.id
+    write_token   = ephemeral.victoriametricscloud_ephemeral_access_token.smoke_write.secret
+    read_token    = ephemeral.victoriametricscloud_ephemeral_access_token.smoke_read.secret
+    timeout       = "3m"
+  }
+}
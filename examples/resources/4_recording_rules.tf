@@ -3,4 +3,18 @@ resource "victoriametricscloud_rule_file" "recording_rules" {
   deployment_id = victoriametricscloud_deployment.single_demo.id
   file_name     = "recording_rules.yaml"
   content       = file("${path.module}/recording_rules.yaml")
+
+  # Labels added to every rule of the file for routing
+  extra_labels = {
+    team       = "platform"
+    deployment = victoriametricscloud_deployment.single_demo.name
+  }
 }
+
+# Upload rules kept as a Prometheus Operator PrometheusRule manifest
+# resource "victoriametricscloud_rule_file" "operator_rules" {
+#   deployment_id  = victoriametricscloud_deployment.single_demo.id
+#   file_name      = "operator_rules.yaml"
+#   content        = file("${path.module}/prometheusrule.yaml")
+#   content_format = "prometheusrule"
+# }
@@ -0,0 +1,26 @@
+# Manage a rule group with native HCL blocks
+resource "victoriametricscloud_rule_group" "availability" {
+  deployment_id = victoriametricscloud_deployment.single_demo.id
+  name          = "availability"
+  interval      = "1m"
+
+  recording_rule {
+    record = "job:up:avg"
+    expr   = "avg(up) by (job)"
+  }
+
+  alerting_rule {
+    alert = "JobDown"
+    expr  = "job:up:avg == 0"
+    for   = "5m"
+
+    labels = {
+      severity = "critical"
+    }
+
+    annotations = {
+      summary     = "Job {{ $labels.job }} is down"
+      description = "All targets of job {{ $labels.job }} have been down for more than 5 minutes."
+    }
+  }
+}
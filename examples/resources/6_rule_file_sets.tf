@@ -0,0 +1,22 @@
+# Sync every rule file of a directory, deleting remote files that are not part of it
+# resource "victoriametricscloud_rule_files" "team_rules" {
+#   deployment_id = victoriametricscloud_deployment.single_demo.id
+#   source_dir    = "${path.module}/rules"
+#   authoritative = true
+# }
+
+# Manage a set of rule files given as a map of file names to content
+resource "victoriametricscloud_rule_files" "platform" {
+  deployment_id = victoriametricscloud_deployment.single_demo.id
+
+  files = {
+    "platform-alerts.yaml" = <<-EOT
+      groups:
+        - name: platform
+          rules:
+            - alert: HighErrorRate
+              expr: sum(rate(http_requests_total{code=~"5.."}[5m])) / sum(rate(http_requests_total[5m])) > 0.05
+              for: 10m
+    EOT
+  }
+}
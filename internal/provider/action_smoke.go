@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	vmcloudapi "github.com/VictoriaMetrics/victoriametrics-cloud-api-go/v1"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// smokeTestMetric is the name of the metric written by the smoke test.
+	smokeTestMetric = "vmcloud_terraform_smoke_test"
+	// defaultSmokeTestTimeout bounds the wait for the written sample to become readable.
+	defaultSmokeTestTimeout = 2 * time.Minute
+	// defaultSmokeTestPollInterval is the delay between attempts to read the written sample.
+	defaultSmokeTestPollInterval = 2 * time.Second
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action                   = &smokeTestAction{}
+	_ action.ActionWithConfigure      = &smokeTestAction{}
+	_ action.ActionWithValidateConfig = &smokeTestAction{}
+)
+
+// NewSmokeTestAction is a helper function to simplify the provider implementation.
+func NewSmokeTestAction() action.Action {
+	return &smokeTestAction{}
+}
+
+// smokeTestAction is the action implementation.
+type smokeTestAction struct {
+	client *vmcloudapi.VMCloudAPIClient
+}
+
+// smokeTestActionModel maps the action schema data.
+type smokeTestActionModel struct {
+	DeploymentID types.String `tfsdk:"deployment_id"`
+	WriteToken   types.String `tfsdk:"write_token"`
+	ReadToken    types.String `tfsdk:"read_token"`
+	TenantID     types.String `tfsdk:"tenant_id"`
+	Timeout      types.String `tfsdk:"timeout"`
+	PollInterval types.String `tfsdk:"poll_interval"`
+}
+
+// Metadata returns the action type name.
+func (a *smokeTestAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_smoke_test"
+}
+
+// Schema defines the schema for the action.
+func (a *smokeTestAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Proves that a VictoriaMetrics Cloud deployment accepts data end to end. " +
+			"Writes a uniquely labeled " + smokeTestMetric + " sample through the Prometheus import API, " +
+			"polls the export API until the sample can be read back, and reports the round-trip latency.",
+		Attributes: map[string]schema.Attribute{
+			"deployment_id": schema.StringAttribute{
+				Description: "ID of the deployment to test.",
+				Required:    true,
+			},
+			"write_token": schema.StringAttribute{
+				Description: "Secret of an access token with write access. Actions cannot mark attributes as sensitive, so prefer an ephemeral value.",
+				Required:    true,
+			},
+			"read_token": schema.StringAttribute{
+				Description: "Secret of an access token with read access. Actions cannot mark attributes as sensitive, so prefer an ephemeral value.",
+				Required:    true,
+			},
+			"tenant_id": schema.StringAttribute{
+				Description: "Optional tenant ID for cluster deployments (format: accountID or accountID:projectID). Defaults to '0'.",
+				Optional:    true,
+			},
+			"timeout": schema.StringAttribute{
+				Description: fmt.Sprintf("Maximum time to wait for the sample to become readable, such as '5m'. Defaults to '%s'.", defaultSmokeTestTimeout),
+				Optional:    true,
+			},
+			"poll_interval": schema.StringAttribute{
+				Description: fmt.Sprintf("Delay between attempts to read the sample back, such as '5s'. Defaults to '%s'.", defaultSmokeTestPollInterval),
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the action.
+func (a *smokeTestAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*vmcloudapi.VMCloudAPIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *vmcloudapi.VMCloudAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+// ValidateConfig checks the timeout and poll interval durations.
+func (a *smokeTestAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config smokeTestActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, value := range map[string]types.String{"timeout": config.Timeout, "poll_interval": config.PollInterval} {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if d, err := time.ParseDuration(value.ValueString()); err != nil || d <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Duration",
+				fmt.Sprintf("Expected a positive duration such as 30s or 2m. Got: %q", value.ValueString()),
+			)
+		}
+	}
+}
+
+// Invoke runs the smoke test.
+func (a *smokeTestAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config smokeTestActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	progress := func(format string, args ...any) {
+		if resp.SendProgress != nil {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf(format, args...)})
+		}
+	}
+
+	timeout := defaultSmokeTestTimeout
+	if !config.Timeout.IsNull() {
+		timeout, _ = time.ParseDuration(config.Timeout.ValueString())
+	}
+	pollInterval := defaultSmokeTestPollInterval
+	if !config.PollInterval.IsNull() {
+		pollInterval, _ = time.ParseDuration(config.PollInterval.ValueString())
+	}
+
+	deployment, err := a.client.GetDeploymentDetails(ctx, config.DeploymentID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Deployment",
+			err.Error(),
+		)
+		return
+	}
+	endpoints := newDeploymentEndpoints(deployment.AccessEndpoint, deployment.Type, config.TenantID.ValueString())
+
+	runID, err := newRunID()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Generate Run ID",
+			err.Error(),
+		)
+		return
+	}
+	selector := fmt.Sprintf("%s{run_id=%q}", smokeTestMetric, runID)
+
+	start := time.Now()
+	sample := fmt.Sprintf("%s 1 %d\n", selector, start.UnixMilli())
+	writer := newDeploymentClient(config.WriteToken.ValueString())
+	if _, err := writer.do(ctx, http.MethodPost, endpoints.Import, nil, strings.NewReader(sample), "text/plain"); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Write Smoke Test Sample",
+			err.Error(),
+		)
+		return
+	}
+	progress("Wrote sample %s to deployment %s", selector, deployment.Name)
+
+	// The export API returns raw samples, so that the sample is not hidden by the query latency offset.
+	pollCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	reader := newDeploymentClient(config.ReadToken.ValueString())
+	query := url.Values{"match[]": []string{selector}}
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for attempt := 1; ; attempt++ {
+		body, err := reader.get(pollCtx, endpoints.Query+"/api/v1/export", query)
+		if err == nil && strings.TrimSpace(string(body)) != "" {
+			break
+		}
+		if err != nil && pollCtx.Err() == nil {
+			progress("Attempt %d to read sample back failed: %s", attempt, err.Error())
+		}
+
+		select {
+		case <-pollCtx.Done():
+			detail := fmt.Sprintf("Sample %s was not readable within %s.", selector, timeout)
+			if err != nil {
+				detail += " Last error: " + err.Error()
+			}
+			resp.Diagnostics.AddError("Smoke Test Timed Out", detail)
+			return
+		case <-ticker.C:
+		}
+	}
+
+	progress("Sample %s was readable after %s", selector, time.Since(start).Round(time.Millisecond))
+}
+
+// newRunID returns a random identifier distinguishing the artifacts of a run, such as smoke test samples.
+func newRunID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	vmcloudapi "github.com/VictoriaMetrics/victoriametrics-cloud-api-go/v1"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &clientConfigDataSource{}
+	_ datasource.DataSourceWithConfigure      = &clientConfigDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &clientConfigDataSource{}
+)
+
+// Supported client configuration formats.
+const (
+	clientConfigFormatVMAgent       = "vmagent"
+	clientConfigFormatPrometheus    = "prometheus"
+	clientConfigFormatGrafana       = "grafana"
+	clientConfigFormatOTelCollector = "otel_collector"
+)
+
+var clientConfigFormats = []string{
+	clientConfigFormatVMAgent,
+	clientConfigFormatPrometheus,
+	clientConfigFormatGrafana,
+	clientConfigFormatOTelCollector,
+}
+
+// NewClientConfigDataSource is a helper function to simplify the provider implementation.
+func NewClientConfigDataSource() datasource.DataSource {
+	return &clientConfigDataSource{}
+}
+
+// clientConfigDataSource is the data source implementation.
+type clientConfigDataSource struct {
+	client *vmcloudapi.VMCloudAPIClient
+}
+
+// clientConfigDataSourceModel maps the data source schema data.
+type clientConfigDataSourceModel struct {
+	DeploymentID   types.String `tfsdk:"deployment_id"`
+	AccessToken    types.String `tfsdk:"access_token"`
+	TenantID       types.String `tfsdk:"tenant_id"`
+	Format         types.String `tfsdk:"format"`
+	RemoteWriteURL types.String `tfsdk:"remote_write_url"`
+	QueryURL       types.String `tfsdk:"query_url"`
+	Content        types.String `tfsdk:"content"`
+}
+
+// Metadata returns the data source type name.
+func (d *clientConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client_config"
+}
+
+// Schema defines the schema for the data source.
+func (d *clientConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Renders client configuration for writing to and querying a VictoriaMetrics Cloud deployment.",
+		Attributes: map[string]schema.Attribute{
+			"deployment_id": schema.StringAttribute{
+				Description: "ID of the deployment to render the configuration for.",
+				Required:    true,
+			},
+			"access_token": schema.StringAttribute{
+				Description: "Secret of the access token used for bearer authentication.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"tenant_id": schema.StringAttribute{
+				Description: "Optional tenant ID for cluster deployments (format: accountID or accountID:projectID). Defaults to '0'.",
+				Optional:    true,
+			},
+			"format": schema.StringAttribute{
+				Description: "Format of the rendered configuration. Valid values: 'vmagent' (command-line flags), 'prometheus' (remote_write block), 'grafana' (datasource provisioning YAML), 'otel_collector' (prometheusremotewrite exporter).",
+				Required:    true,
+			},
+			"remote_write_url": schema.StringAttribute{
+				Description: "Prometheus remote write URL of the deployment.",
+				Computed:    true,
+			},
+			"query_url": schema.StringAttribute{
+				Description: "Base URL of the Prometheus querying API of the deployment.",
+				Computed:    true,
+			},
+			"content": schema.StringAttribute{
+				Description: "Rendered client configuration.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *clientConfigDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*vmcloudapi.VMCloudAPIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *vmcloudapi.VMCloudAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig validates the data source configuration.
+func (d *clientConfigDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var format types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("format"), &format)...)
+	if resp.Diagnostics.HasError() || format.IsNull() || format.IsUnknown() {
+		return
+	}
+
+	for _, f := range clientConfigFormats {
+		if format.ValueString() == f {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("format"),
+		"Invalid Client Configuration Format",
+		fmt.Sprintf("Expected one of: %s. Got: %q", strings.Join(clientConfigFormats, ", "), format.ValueString()),
+	)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *clientConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state clientConfigDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := d.client.GetDeploymentDetails(ctx, state.DeploymentID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Deployment",
+			err.Error(),
+		)
+		return
+	}
+
+	endpoints := newDeploymentEndpoints(deployment.AccessEndpoint, deployment.Type, state.TenantID.ValueString())
+	content, err := renderClientConfig(state.Format.ValueString(), deployment.Name, endpoints, state.AccessToken.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Render Client Configuration",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	state.RemoteWriteURL = types.StringValue(endpoints.RemoteWrite)
+	state.QueryURL = types.StringValue(endpoints.Query)
+	state.Content = types.StringValue(content)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// renderClientConfig renders the client configuration in the given format.
+func renderClientConfig(format, deploymentName string, endpoints deploymentEndpoints, token string) (string, error) {
+	var doc any
+	switch format {
+	case clientConfigFormatVMAgent:
+		return fmt.Sprintf("-remoteWrite.url=%s\n-remoteWrite.bearerToken=%s\n", endpoints.RemoteWrite, token), nil
+	case clientConfigFormatPrometheus:
+		doc = map[string]any{
+			"remote_write": []any{
+				map[string]any{
+					"url": endpoints.RemoteWrite,
+					"authorization": map[string]any{
+						"type":        "Bearer",
+						"credentials": token,
+					},
+				},
+			},
+		}
+	case clientConfigFormatGrafana:
+		doc = map[string]any{
+			"apiVersion": 1,
+			"datasources": []any{
+				map[string]any{
+					"name":   deploymentName,
+					"type":   "prometheus",
+					"access": "proxy",
+					"url":    endpoints.Query,
+					"jsonData": map[string]any{
+						"httpHeaderName1": "Authorization",
+					},
+					"secureJsonData": map[string]any{
+						"httpHeaderValue1": "Bearer " + token,
+					},
+				},
+			},
+		}
+	case clientConfigFormatOTelCollector:
+		doc = map[string]any{
+			"exporters": map[string]any{
+				"prometheusremotewrite": map[string]any{
+					"endpoint": endpoints.RemoteWrite,
+					"headers": map[string]any{
+						"Authorization": "Bearer " + token,
+					},
+				},
+			},
+		}
+	default:
+		return "", fmt.Errorf("unsupported format %q", format)
+	}
+
+	content, err := marshalYAML(doc)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal %s configuration: %w", format, err)
+	}
+	return content, nil
+}
+
+// marshalYAML serializes v as a YAML document with two-space indentation.
+func marshalYAML(v any) (string, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(v); err != nil {
+		return "", err
+	}
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -33,8 +33,9 @@ type cloudProvidersDataSourceModel struct {
 
 // cloudProviderModel maps cloud provider data.
 type cloudProviderModel struct {
-	ID  types.String `tfsdk:"id"`
-	URL types.String `tfsdk:"url"`
+	ID      types.String   `tfsdk:"id"`
+	URL     types.String   `tfsdk:"url"`
+	Regions []types.String `tfsdk:"regions"`
 }
 
 // Metadata returns the data source type name.
@@ -60,6 +61,11 @@ func (d *cloudProvidersDataSource) Schema(_ context.Context, _ datasource.Schema
 							Description: "URL to the cloud provider website.",
 							Computed:    true,
 						},
+						"regions": schema.ListAttribute{
+							Description: "Names of the deployment regions of the cloud provider.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -98,11 +104,26 @@ func (d *cloudProvidersDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
+	regions, err := d.client.ListRegions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Regions",
+			err.Error(),
+		)
+		return
+	}
+
 	// Map response to state
 	for _, provider := range providers {
 		providerState := cloudProviderModel{
-			ID:  types.StringValue(provider.ID.String()),
-			URL: types.StringValue(provider.URL),
+			ID:      types.StringValue(provider.ID.String()),
+			URL:     types.StringValue(provider.URL),
+			Regions: []types.String{},
+		}
+		for _, region := range regions {
+			if region.CloudProvider == provider.ID {
+				providerState.Regions = append(providerState.Regions, types.StringValue(region.Name))
+			}
 		}
 		state.CloudProviders = append(state.CloudProviders, providerState)
 	}
@@ -8,13 +8,15 @@ import (
 	vmcloudapi "github.com/VictoriaMetrics/victoriametrics-cloud-api-go/v1"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource              = &deploymentDataSource{}
-	_ datasource.DataSourceWithConfigure = &deploymentDataSource{}
+	_ datasource.DataSource                   = &deploymentDataSource{}
+	_ datasource.DataSourceWithConfigure      = &deploymentDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &deploymentDataSource{}
 )
 
 // NewDeploymentDataSource is a helper function to simplify the provider implementation.
@@ -48,6 +50,12 @@ type deploymentDataSourceModel struct {
 	ComputeCost       types.Float64 `tfsdk:"compute_cost"`
 	StorageCost       types.Float64 `tfsdk:"storage_cost"`
 	TotalCost         types.Float64 `tfsdk:"total_cost"`
+	SingleFlags       types.List    `tfsdk:"single_flags"`
+	SelectFlags       types.List    `tfsdk:"select_flags"`
+	StorageFlags      types.List    `tfsdk:"storage_flags"`
+	InsertFlags       types.List    `tfsdk:"insert_flags"`
+	RemoteWriteURL    types.String  `tfsdk:"remote_write_url"`
+	QueryURL          types.String  `tfsdk:"query_url"`
 }
 
 // Metadata returns the data source type name.
@@ -61,11 +69,13 @@ func (d *deploymentDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 		Description: "Fetches details of a specific VictoriaMetrics Cloud deployment.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "Unique identifier of the deployment.",
-				Required:    true,
+				Description: "Unique identifier of the deployment. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"name": schema.StringAttribute{
-				Description: "Human-readable name of the deployment.",
+				Description: "Human-readable name of the deployment. Exactly one of id or name must be set; looking up a name shared by several deployments fails.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"type": schema.StringAttribute{
@@ -136,6 +146,34 @@ func (d *deploymentDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				Description: "Total monthly cost in USD.",
 				Computed:    true,
 			},
+			"single_flags": schema.ListAttribute{
+				Description: "Custom command-line flags of the vmsingle component. Empty for cluster deployments.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"select_flags": schema.ListAttribute{
+				Description: "Custom command-line flags of the vmselect component. Empty for single-node deployments.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"storage_flags": schema.ListAttribute{
+				Description: "Custom command-line flags of the vmstorage component. Empty for single-node deployments.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"insert_flags": schema.ListAttribute{
+				Description: "Custom command-line flags of the vminsert component. Empty for single-node deployments.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"remote_write_url": schema.StringAttribute{
+				Description: "Prometheus remote write URL of the deployment. Cluster deployments use tenant 0.",
+				Computed:    true,
+			},
+			"query_url": schema.StringAttribute{
+				Description: "Base URL of the Prometheus querying API of the deployment. Cluster deployments use tenant 0.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -158,17 +196,47 @@ func (d *deploymentDataSource) Configure(_ context.Context, req datasource.Confi
 	d.client = client
 }
 
+// ValidateConfig checks that the deployment is referenced by exactly one of id or name.
+func (d *deploymentDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config deploymentDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ID.IsNull() == config.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid Attribute Combination",
+			"Exactly one of id or name must be set.",
+		)
+	}
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (d *deploymentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state deploymentDataSourceModel
 
-	// Get deployment ID from config
+	// Get deployment ID or name from config
 	diags := req.Config.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if state.ID.IsNull() {
+		summary, err := findDeploymentByName(ctx, d.client, state.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Unable to Find Deployment",
+				err.Error(),
+			)
+			return
+		}
+		state.ID = types.StringValue(summary.ID)
+	}
+
 	deployment, err := d.client.GetDeploymentDetails(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -198,6 +266,30 @@ func (d *deploymentDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	state.StorageCost = types.Float64Value(deployment.Price.StorageCost)
 	state.TotalCost = types.Float64Value(deployment.Price.TotalCost)
 
+	for _, flags := range []struct {
+		target   *types.List
+		settings []string
+	}{
+		{&state.SingleFlags, deployment.VMSingleSettings},
+		{&state.SelectFlags, deployment.VMSelectSettings},
+		{&state.StorageFlags, deployment.VMStorageSettings},
+		{&state.InsertFlags, deployment.VMInsertSettings},
+	} {
+		if flags.settings == nil {
+			flags.settings = []string{}
+		}
+		list, d := types.ListValueFrom(ctx, types.StringType, flags.settings)
+		resp.Diagnostics.Append(d...)
+		*flags.target = list
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoints := newDeploymentEndpoints(deployment.AccessEndpoint, deployment.Type, defaultTenantID)
+	state.RemoteWriteURL = types.StringValue(endpoints.RemoteWrite)
+	state.QueryURL = types.StringValue(endpoints.Query)
+
 	// Set state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
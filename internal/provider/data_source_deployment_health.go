@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+
+	vmcloudapi "github.com/VictoriaMetrics/victoriametrics-cloud-api-go/v1"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultTopMetrics is the number of metric names with the most series reported by default.
+const defaultTopMetrics = 10
+
+// vmAppVersionRe matches the short_version label of the vm_app_version metric exposed on /metrics.
+// The /api/v1/status/buildinfo endpoint is not used, since it reports a fixed Prometheus compatibility version.
+var vmAppVersionRe = regexp.MustCompile(`(?m)^vm_app_version\{[^}]*\bshort_version="([^"]+)"`)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &deploymentHealthDataSource{}
+	_ datasource.DataSourceWithConfigure = &deploymentHealthDataSource{}
+)
+
+// NewDeploymentHealthDataSource is a helper function to simplify the provider implementation.
+func NewDeploymentHealthDataSource() datasource.DataSource {
+	return &deploymentHealthDataSource{}
+}
+
+// deploymentHealthDataSource is the data source implementation.
+type deploymentHealthDataSource struct {
+	client *vmcloudapi.VMCloudAPIClient
+}
+
+// deploymentHealthDataSourceModel maps the data source schema data.
+type deploymentHealthDataSourceModel struct {
+	DeploymentID types.String     `tfsdk:"deployment_id"`
+	AccessToken  types.String     `tfsdk:"access_token"`
+	TenantID     types.String     `tfsdk:"tenant_id"`
+	TopN         types.Int64      `tfsdk:"top_n"`
+	Ready        types.Bool       `tfsdk:"ready"`
+	HealthError  types.String     `tfsdk:"health_error"`
+	Version      types.String     `tfsdk:"version"`
+	SeriesToday  types.Int64      `tfsdk:"series_today"`
+	TopMetrics   []topMetricModel `tfsdk:"top_metrics"`
+}
+
+// topMetricModel maps the series count of a metric name.
+type topMetricModel struct {
+	Name        types.String `tfsdk:"name"`
+	SeriesCount types.Int64  `tfsdk:"series_count"`
+}
+
+// tsdbStatus maps the data of the /api/v1/status/tsdb response.
+type tsdbStatus struct {
+	TotalSeries             int64 `json:"totalSeries"`
+	SeriesCountByMetricName []struct {
+		Name  string `json:"name"`
+		Value int64  `json:"value"`
+	} `json:"seriesCountByMetricName"`
+}
+
+// Metadata returns the data source type name.
+func (d *deploymentHealthDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_health"
+}
+
+// Schema defines the schema for the data source.
+func (d *deploymentHealthDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Checks the data plane of a VictoriaMetrics Cloud deployment by querying its access endpoint. " +
+			"An unhealthy deployment is reported through ready and health_error rather than as an error, so the data source can drive check blocks.",
+		Attributes: map[string]schema.Attribute{
+			"deployment_id": schema.StringAttribute{
+				Description: "ID of the deployment.",
+				Required:    true,
+			},
+			"access_token": schema.StringAttribute{
+				Description: "Secret of an access token with read access, used for bearer authentication.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"tenant_id": schema.StringAttribute{
+				Description: "Optional tenant ID for cluster deployments (format: accountID or accountID:projectID). Defaults to '0'.",
+				Optional:    true,
+			},
+			"top_n": schema.Int64Attribute{
+				Description: fmt.Sprintf("Number of metric names with the most series to report. Defaults to %d.", defaultTopMetrics),
+				Optional:    true,
+			},
+			"ready": schema.BoolAttribute{
+				Description: "Whether the deployment answers its health check.",
+				Computed:    true,
+			},
+			"health_error": schema.StringAttribute{
+				Description: "Reason the health check failed. Null if the deployment is ready.",
+				Computed:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "Version of VictoriaMetrics running in the deployment, read from the short_version label of vm_app_version on its /metrics endpoint. " +
+					"Null if the deployment is not ready or does not expose the metric.",
+				Computed: true,
+			},
+			"series_today": schema.Int64Attribute{
+				Description: "Number of unique series with samples ingested since the start of the current UTC day, as reported by /api/v1/status/tsdb. " +
+					"This is not the number of currently active series. Null if the deployment is not ready.",
+				Computed: true,
+			},
+			"top_metrics": schema.ListNestedAttribute{
+				Description: "Metric names with the most series today, in descending order of series count. Empty if the deployment is not ready.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Metric name.",
+							Computed:    true,
+						},
+						"series_count": schema.Int64Attribute{
+							Description: "Number of series of the metric.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *deploymentHealthDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*vmcloudapi.VMCloudAPIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *vmcloudapi.VMCloudAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *deploymentHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state deploymentHealthDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	topN := int64(defaultTopMetrics)
+	if !state.TopN.IsNull() {
+		topN = state.TopN.ValueInt64()
+		if topN <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("top_n"),
+				"Invalid Top N",
+				fmt.Sprintf("top_n must be positive, got %d.", topN),
+			)
+			return
+		}
+	}
+
+	deployment, err := d.client.GetDeploymentDetails(ctx, state.DeploymentID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Deployment",
+			err.Error(),
+		)
+		return
+	}
+
+	client := newDeploymentClient(state.AccessToken.ValueString())
+	endpoints := newDeploymentEndpoints(deployment.AccessEndpoint, deployment.Type, state.TenantID.ValueString())
+
+	// Map response to state
+	state.TopMetrics = []topMetricModel{}
+	state.Version = types.StringNull()
+	state.SeriesToday = types.Int64Null()
+	if _, err := client.get(ctx, deploymentBaseURL(deployment.AccessEndpoint)+"/health", nil); err != nil {
+		state.Ready = types.BoolValue(false)
+		state.HealthError = types.StringValue(err.Error())
+
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	state.Ready = types.BoolValue(true)
+	state.HealthError = types.StringNull()
+
+	metrics, err := client.get(ctx, deploymentBaseURL(deployment.AccessEndpoint)+"/metrics", nil)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Read Deployment Version",
+			err.Error(),
+		)
+	} else if match := vmAppVersionRe.FindSubmatch(metrics); match == nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Read Deployment Version",
+			"The /metrics endpoint of the deployment does not expose vm_app_version.",
+		)
+	} else {
+		state.Version = types.StringValue(string(match[1]))
+	}
+
+	var status tsdbStatus
+	query := url.Values{"topN": []string{strconv.FormatInt(topN, 10)}}
+	if err := client.getPromAPI(ctx, endpoints.Query+"/api/v1/status/tsdb", query, &status); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Deployment TSDB Status",
+			err.Error(),
+		)
+		return
+	}
+	state.SeriesToday = types.Int64Value(status.TotalSeries)
+	for _, metric := range status.SeriesCountByMetricName {
+		state.TopMetrics = append(state.TopMetrics, topMetricModel{
+			Name:        types.StringValue(metric.Name),
+			SeriesCount: types.Int64Value(metric.Value),
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -3,18 +3,21 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"time"
 
 	vmcloudapi "github.com/VictoriaMetrics/victoriametrics-cloud-api-go/v1"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource              = &deploymentsDataSource{}
-	_ datasource.DataSourceWithConfigure = &deploymentsDataSource{}
+	_ datasource.DataSource                   = &deploymentsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &deploymentsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &deploymentsDataSource{}
 )
 
 // NewDeploymentsDataSource is a helper function to simplify the provider implementation.
@@ -29,6 +32,11 @@ type deploymentsDataSource struct {
 
 // deploymentsDataSourceModel maps the data source schema data.
 type deploymentsDataSourceModel struct {
+	NameRegex   types.String             `tfsdk:"name_regex"`
+	Status      types.String             `tfsdk:"status"`
+	Region      types.String             `tfsdk:"region"`
+	Type        types.String             `tfsdk:"type"`
+	Tier        types.Int64              `tfsdk:"tier"`
 	Deployments []deploymentSummaryModel `tfsdk:"deployments"`
 }
 
@@ -53,8 +61,28 @@ func (d *deploymentsDataSource) Metadata(_ context.Context, req datasource.Metad
 // Schema defines the schema for the data source.
 func (d *deploymentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches the list of VictoriaMetrics Cloud deployments.",
+		Description: "Fetches the list of VictoriaMetrics Cloud deployments, optionally filtered. All set filters must match.",
 		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Description: "Only return deployments whose name matches this regular expression (RE2 syntax, unanchored).",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Only return deployments with this status.",
+				Optional:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Only return deployments in this region.",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only return deployments of this type (single_node or cluster).",
+				Optional:    true,
+			},
+			"tier": schema.Int64Attribute{
+				Description: "Only return deployments of this tier.",
+				Optional:    true,
+			},
 			"deployments": schema.ListNestedAttribute{
 				Description: "List of deployments.",
 				Computed:    true,
@@ -121,11 +149,48 @@ func (d *deploymentsDataSource) Configure(_ context.Context, req datasource.Conf
 	d.client = client
 }
 
+// ValidateConfig checks that name_regex is a valid regular expression.
+func (d *deploymentsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var nameRegex types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name_regex"), &nameRegex)...)
+	if resp.Diagnostics.HasError() || nameRegex.IsNull() || nameRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(nameRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid Name Regex",
+			err.Error(),
+		)
+	}
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (d *deploymentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state deploymentsDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	state.Deployments = []deploymentSummaryModel{}
 
+	var nameRe *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		re, err := regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Name Regex",
+				err.Error(),
+			)
+			return
+		}
+		nameRe = re
+	}
+
 	deployments, err := d.client.ListDeployments(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -137,6 +202,9 @@ func (d *deploymentsDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 	// Map response to state
 	for _, deployment := range deployments {
+		if !state.matches(deployment, nameRe) {
+			continue
+		}
 		deploymentState := deploymentSummaryModel{
 			ID:            types.StringValue(deployment.ID),
 			Name:          types.StringValue(deployment.Name),
@@ -152,6 +220,26 @@ func (d *deploymentsDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
+
+// matches reports whether the deployment passes every set filter. nameRe is the compiled name_regex, if set.
+func (m deploymentsDataSourceModel) matches(deployment vmcloudapi.DeploymentSummary, nameRe *regexp.Regexp) bool {
+	if nameRe != nil && !nameRe.MatchString(deployment.Name) {
+		return false
+	}
+	if !m.Status.IsNull() && deployment.Status.String() != m.Status.ValueString() {
+		return false
+	}
+	if !m.Region.IsNull() && deployment.Region != m.Region.ValueString() {
+		return false
+	}
+	if !m.Type.IsNull() && deployment.Type.String() != m.Type.ValueString() {
+		return false
+	}
+	if !m.Tier.IsNull() && int64(deployment.Tier) != m.Tier.ValueInt64() {
+		return false
+	}
+	return true
+}
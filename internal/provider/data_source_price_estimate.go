@@ -0,0 +1,353 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	vmcloudapi "github.com/VictoriaMetrics/victoriametrics-cloud-api-go/v1"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// hoursPerMonth is the number of hours in the average month used to turn hourly compute prices into monthly costs.
+const hoursPerMonth = 730
+
+// gbPerTB is the number of gigabytes in a terabyte of deployment storage.
+const gbPerTB = 1024
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &priceEstimateDataSource{}
+	_ datasource.DataSourceWithConfigure = &priceEstimateDataSource{}
+)
+
+// NewPriceEstimateDataSource is a helper function to simplify the provider implementation.
+func NewPriceEstimateDataSource() datasource.DataSource {
+	return &priceEstimateDataSource{}
+}
+
+// priceEstimateDataSource is the data source implementation.
+type priceEstimateDataSource struct {
+	client *vmcloudapi.VMCloudAPIClient
+}
+
+// priceEstimateDataSourceModel maps the data source schema data.
+type priceEstimateDataSourceModel struct {
+	StoragePricePerGBMonth types.Float64        `tfsdk:"storage_price_per_gb_month"`
+	Scenarios              []priceScenarioModel `tfsdk:"scenarios"`
+	Estimates              []priceEstimateModel `tfsdk:"estimates"`
+}
+
+// priceScenarioModel maps a candidate deployment shape.
+type priceScenarioModel struct {
+	Tier            types.Int64  `tfsdk:"tier"`
+	StorageSize     types.Int64  `tfsdk:"storage_size"`
+	StorageSizeUnit types.String `tfsdk:"storage_size_unit"`
+	Type            types.String `tfsdk:"type"`
+	CloudProvider   types.String `tfsdk:"cloud_provider"`
+}
+
+// priceEstimateModel maps the monthly costs of a scenario.
+type priceEstimateModel struct {
+	Tier                   types.Int64   `tfsdk:"tier"`
+	TierName               types.String  `tfsdk:"tier_name"`
+	Type                   types.String  `tfsdk:"type"`
+	CloudProvider          types.String  `tfsdk:"cloud_provider"`
+	StorageSizeGb          types.Int64   `tfsdk:"storage_size_gb"`
+	StoragePricePerGBMonth types.Float64 `tfsdk:"storage_price_per_gb_month"`
+	ReferenceDeploymentID  types.String  `tfsdk:"reference_deployment_id"`
+	ComputeCost            types.Float64 `tfsdk:"compute_cost"`
+	StorageCost            types.Float64 `tfsdk:"storage_cost"`
+	TotalCost              types.Float64 `tfsdk:"total_cost"`
+}
+
+// Metadata returns the data source type name.
+func (d *priceEstimateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_price_estimate"
+}
+
+// Schema defines the schema for the data source.
+func (d *priceEstimateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Estimates the monthly cost of candidate VictoriaMetrics Cloud deployments. " +
+			"Compute cost is the tier compute cost per hour multiplied by 730 hours. " +
+			"Storage cost is the storage size in GB (1 TB = 1024 GB) multiplied by the storage price per GB-month, " +
+			"which is the reported storage cost divided by the storage size of a reference deployment: " +
+			"the first deployment, by ID, of the cloud provider of the tier that has storage. " +
+			"The compute cost of the reference deployment's tier is compared with its reported compute cost, " +
+			"and a warning is raised if the estimate does not reproduce it. " +
+			"Costs are in USD, rounded to cents, and exclude network costs, like the costs reported for deployments.",
+		Attributes: map[string]schema.Attribute{
+			"storage_price_per_gb_month": schema.Float64Attribute{
+				Description: "Storage price in USD per GB-month, used for every scenario instead of the price of a reference deployment, " +
+					"e.g. when no deployment of the cloud provider exists yet.",
+				Optional: true,
+			},
+			"scenarios": schema.ListNestedAttribute{
+				Description: "Candidate deployment shapes to estimate.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"tier": schema.Int64Attribute{
+							Description: "Tier identifier.",
+							Required:    true,
+						},
+						"storage_size": schema.Int64Attribute{
+							Description: "Storage size in units specified in storage_size_unit.",
+							Required:    true,
+						},
+						"storage_size_unit": schema.StringAttribute{
+							Description: "Storage size unit. Valid values: 'GB', 'TB'.",
+							Required:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Deployment type (single_node or cluster). Tier IDs are unique per type, so set it when the ID is ambiguous.",
+							Optional:    true,
+						},
+						"cloud_provider": schema.StringAttribute{
+							Description: "Cloud provider of the tier. Set it when the tier ID is ambiguous.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"estimates": schema.ListNestedAttribute{
+				Description: "Monthly costs of every scenario, in the order of scenarios.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"tier": schema.Int64Attribute{
+							Description: "Tier identifier.",
+							Computed:    true,
+						},
+						"tier_name": schema.StringAttribute{
+							Description: "Name of the tier.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Deployment type of the tier.",
+							Computed:    true,
+						},
+						"cloud_provider": schema.StringAttribute{
+							Description: "Cloud provider of the tier.",
+							Computed:    true,
+						},
+						"storage_size_gb": schema.Int64Attribute{
+							Description: "Storage size in GB.",
+							Computed:    true,
+						},
+						"storage_price_per_gb_month": schema.Float64Attribute{
+							Description: "Storage price in USD per GB-month used for the estimate.",
+							Computed:    true,
+						},
+						"reference_deployment_id": schema.StringAttribute{
+							Description: "ID of the deployment the storage price is derived from. Null if storage_price_per_gb_month is set.",
+							Computed:    true,
+						},
+						"compute_cost": schema.Float64Attribute{
+							Description: "Monthly compute cost in USD.",
+							Computed:    true,
+						},
+						"storage_cost": schema.Float64Attribute{
+							Description: "Monthly storage cost in USD.",
+							Computed:    true,
+						},
+						"total_cost": schema.Float64Attribute{
+							Description: "Total monthly cost in USD.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *priceEstimateDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*vmcloudapi.VMCloudAPIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *vmcloudapi.VMCloudAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *priceEstimateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state priceEstimateDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tiers, err := d.client.ListTiers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tiers",
+			err.Error(),
+		)
+		return
+	}
+
+	// Reference deployments are looked up once per cloud provider.
+	var deployments vmcloudapi.DeploymentSummaryList
+	references := make(map[vmcloudapi.DeploymentCloudProvider]*vmcloudapi.DeploymentInfo)
+
+	// Map response to state
+	state.Estimates = []priceEstimateModel{}
+	for i, scenario := range state.Scenarios {
+		p := path.Root("scenarios").AtListIndex(i)
+
+		var matches vmcloudapi.TierInfoList
+		for _, tier := range filterTiers(tiers, scenario.Type, scenario.CloudProvider) {
+			if int64(tier.ID) == scenario.Tier.ValueInt64() {
+				matches = append(matches, tier)
+			}
+		}
+		if len(matches) != 1 {
+			detail := fmt.Sprintf("No tier with ID %d matches the type and cloud provider of the scenario.", scenario.Tier.ValueInt64())
+			if len(matches) > 1 {
+				detail = fmt.Sprintf("Tier ID %d is used by %d tiers. Set type and cloud_provider to select one.", scenario.Tier.ValueInt64(), len(matches))
+			}
+			resp.Diagnostics.AddAttributeError(p.AtName("tier"), "Unable to Find Tier", detail)
+			continue
+		}
+		tier := matches[0]
+
+		storageSizeGb := scenario.StorageSize.ValueInt64()
+		switch scenario.StorageSizeUnit.ValueString() {
+		case string(vmcloudapi.StorageUnitGB):
+		case string(vmcloudapi.StorageUnitTB):
+			storageSizeGb *= gbPerTB
+		default:
+			resp.Diagnostics.AddAttributeError(p.AtName("storage_size_unit"), "Invalid Storage Size Unit", fmt.Sprintf(
+				"Expected one of: %s. Got: %q", strings.Join([]string{string(vmcloudapi.StorageUnitGB), string(vmcloudapi.StorageUnitTB)}, ", "), scenario.StorageSizeUnit.ValueString(),
+			))
+			continue
+		}
+
+		storagePrice := state.StoragePricePerGBMonth.ValueFloat64()
+		referenceID := types.StringNull()
+		if state.StoragePricePerGBMonth.IsNull() {
+			reference, ok := references[tier.CloudProvider]
+			if !ok {
+				if deployments == nil {
+					deployments, err = d.client.ListDeployments(ctx)
+					if err != nil {
+						resp.Diagnostics.AddError(
+							"Unable to Read Deployments",
+							err.Error(),
+						)
+						return
+					}
+				}
+				reference, err = findPriceReference(ctx, d.client, deployments, tier.CloudProvider)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to Read Deployment",
+						err.Error(),
+					)
+					return
+				}
+				references[tier.CloudProvider] = reference
+				if reference != nil {
+					if detail := checkPriceReference(*reference, tiers); detail != "" {
+						resp.Diagnostics.AddWarning("Estimate Does Not Match Deployment Price", detail)
+					}
+				}
+			}
+			if reference == nil {
+				resp.Diagnostics.AddAttributeError(p.AtName("tier"), "Unable to Determine Storage Price", fmt.Sprintf(
+					"No deployment of cloud provider %q with storage is visible to the API key. Set storage_price_per_gb_month.", tier.CloudProvider,
+				))
+				continue
+			}
+			storagePrice = reference.Price.StorageCost / float64(reference.StorageSizeGb)
+			referenceID = types.StringValue(reference.ID)
+		}
+
+		computeCost := roundCents(tier.ComputeCostPerHour * hoursPerMonth)
+		storageCost := roundCents(float64(storageSizeGb) * storagePrice)
+		state.Estimates = append(state.Estimates, priceEstimateModel{
+			Tier:                   types.Int64Value(int64(tier.ID)),
+			TierName:               types.StringValue(tier.Name),
+			Type:                   types.StringValue(tier.Type.String()),
+			CloudProvider:          types.StringValue(tier.CloudProvider.String()),
+			StorageSizeGb:          types.Int64Value(storageSizeGb),
+			StoragePricePerGBMonth: types.Float64Value(storagePrice),
+			ReferenceDeploymentID:  referenceID,
+			ComputeCost:            types.Float64Value(computeCost),
+			StorageCost:            types.Float64Value(storageCost),
+			TotalCost:              types.Float64Value(roundCents(computeCost + storageCost)),
+		})
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// findPriceReference returns the first deployment, by ID, of the cloud provider that has storage,
+// or nil if there is none.
+func findPriceReference(ctx context.Context, client *vmcloudapi.VMCloudAPIClient, deployments vmcloudapi.DeploymentSummaryList, provider vmcloudapi.DeploymentCloudProvider) (*vmcloudapi.DeploymentInfo, error) {
+	candidates := slices.DeleteFunc(slices.Clone(deployments), func(d vmcloudapi.DeploymentSummary) bool {
+		return d.CloudProvider != provider
+	})
+	slices.SortFunc(candidates, func(a, b vmcloudapi.DeploymentSummary) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	for _, summary := range candidates {
+		deployment, err := client.GetDeploymentDetails(ctx, summary.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read deployment %s: %w", summary.ID, err)
+		}
+		if deployment.StorageSizeGb > 0 {
+			return &deployment, nil
+		}
+	}
+	return nil, nil
+}
+
+// checkPriceReference checks that the compute cost model reproduces the compute cost reported for
+// the reference deployment. It returns a description of the mismatch, or an empty string.
+func checkPriceReference(reference vmcloudapi.DeploymentInfo, tiers vmcloudapi.TierInfoList) string {
+	i := slices.IndexFunc(tiers, func(t vmcloudapi.TierInfo) bool {
+		return t.ID == reference.Tier && t.Type == reference.Type && t.CloudProvider == reference.CloudProvider
+	})
+	if i < 0 {
+		return fmt.Sprintf("Tier %d of reference deployment %s is not listed, so the compute cost model cannot be checked.", reference.Tier, reference.ID)
+	}
+
+	estimated := roundCents(tiers[i].ComputeCostPerHour * hoursPerMonth)
+	if math.Abs(estimated-reference.Price.ComputeCost) < 0.01 {
+		return ""
+	}
+	return fmt.Sprintf(
+		"Reference deployment %s reports a monthly compute cost of %.2f USD, but tier %d estimates %.2f USD. Compute cost estimates may not match the API.",
+		reference.ID, reference.Price.ComputeCost, reference.Tier, estimated,
+	)
+}
+
+// roundCents rounds a USD amount to cents.
+func roundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -0,0 +1,410 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/VictoriaMetrics/metricsql"
+	vmcloudapi "github.com/VictoriaMetrics/victoriametrics-cloud-api-go/v1"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &queryDataSource{}
+	_ datasource.DataSourceWithConfigure      = &queryDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &queryDataSource{}
+)
+
+// NewQueryDataSource is a helper function to simplify the provider implementation.
+func NewQueryDataSource() datasource.DataSource {
+	return &queryDataSource{}
+}
+
+// queryDataSource is the data source implementation.
+type queryDataSource struct {
+	client *vmcloudapi.VMCloudAPIClient
+}
+
+// queryDataSourceModel maps the data source schema data.
+type queryDataSourceModel struct {
+	DeploymentID   types.String       `tfsdk:"deployment_id"`
+	AccessEndpoint types.String       `tfsdk:"access_endpoint"`
+	Type           types.String       `tfsdk:"type"`
+	AccessToken    types.String       `tfsdk:"access_token"`
+	TenantID       types.String       `tfsdk:"tenant_id"`
+	Query          types.String       `tfsdk:"query"`
+	Time           types.String       `tfsdk:"time"`
+	Start          types.String       `tfsdk:"start"`
+	End            types.String       `tfsdk:"end"`
+	Step           types.String       `tfsdk:"step"`
+	ResultType     types.String       `tfsdk:"result_type"`
+	Results        []queryResultModel `tfsdk:"results"`
+}
+
+// queryResultModel maps a series of the query result.
+type queryResultModel struct {
+	Labels  map[string]string  `tfsdk:"labels"`
+	Samples []querySampleModel `tfsdk:"samples"`
+}
+
+// querySampleModel maps a sample of a series of the query result.
+type querySampleModel struct {
+	Timestamp types.Float64 `tfsdk:"timestamp"`
+	Value     types.Float64 `tfsdk:"value"`
+}
+
+// queryData maps the data of /api/v1/query and /api/v1/query_range responses.
+type queryData struct {
+	ResultType string          `json:"resultType"`
+	Result     json.RawMessage `json:"result"`
+}
+
+// querySeries maps a series of vector and matrix query results.
+type querySeries struct {
+	Metric map[string]string   `json:"metric"`
+	Value  []json.RawMessage   `json:"value"`
+	Values [][]json.RawMessage `json:"values"`
+}
+
+// Metadata returns the data source type name.
+func (d *queryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_query"
+}
+
+// Schema defines the schema for the data source.
+func (d *queryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a MetricsQL query against a VictoriaMetrics Cloud deployment. " +
+			"Runs an instant query, or a range query if start is set.",
+		Attributes: map[string]schema.Attribute{
+			"deployment_id": schema.StringAttribute{
+				Description: "ID of the deployment to query. Exactly one of deployment_id or access_endpoint must be set.",
+				Optional:    true,
+			},
+			"access_endpoint": schema.StringAttribute{
+				Description: "Access endpoint of the deployment to query, which avoids a call to the VictoriaMetrics Cloud API. Exactly one of deployment_id or access_endpoint must be set.",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the deployment behind access_endpoint. Valid values: 'single_node', 'cluster'. Defaults to 'single_node'.",
+				Optional:    true,
+			},
+			"access_token": schema.StringAttribute{
+				Description: "Secret of an access token with read access, used for bearer authentication.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"tenant_id": schema.StringAttribute{
+				Description: "Optional tenant ID for cluster deployments (format: accountID or accountID:projectID). Defaults to '0'.",
+				Optional:    true,
+			},
+			"query": schema.StringAttribute{
+				Description: "MetricsQL expression to evaluate.",
+				Required:    true,
+			},
+			"time": schema.StringAttribute{
+				Description: "Evaluation time of an instant query, in RFC 3339 format. Defaults to the current time.",
+				Optional:    true,
+			},
+			"start": schema.StringAttribute{
+				Description: "Start of a range query, in RFC 3339 format.",
+				Optional:    true,
+			},
+			"end": schema.StringAttribute{
+				Description: "End of a range query, in RFC 3339 format. Defaults to the current time.",
+				Optional:    true,
+			},
+			"step": schema.StringAttribute{
+				Description: "Resolution step of a range query, as a duration such as '1m' or a number of seconds such as '60'. Defaults to the deployment default.",
+				Optional:    true,
+			},
+			"result_type": schema.StringAttribute{
+				Description: "Type of the query result: 'vector', 'matrix' or 'scalar'.",
+				Computed:    true,
+			},
+			"results": schema.ListNestedAttribute{
+				Description: "Series of the query result. A scalar result is a single series without labels.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"labels": schema.MapAttribute{
+							Description: "Labels of the series, including __name__ if the series has a metric name.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"samples": schema.ListNestedAttribute{
+							Description: "Samples of the series, a single one for instant queries.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"timestamp": schema.Float64Attribute{
+										Description: "Timestamp of the sample, in Unix seconds.",
+										Computed:    true,
+									},
+									"value": schema.Float64Attribute{
+										Description: "Value of the sample. Null for NaN and infinite values.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *queryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*vmcloudapi.VMCloudAPIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *vmcloudapi.VMCloudAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig checks the deployment reference, the query and the evaluation time or range.
+func (d *queryDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config queryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.DeploymentID.IsNull() == config.AccessEndpoint.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("deployment_id"),
+			"Invalid Attribute Combination",
+			"Exactly one of deployment_id or access_endpoint must be set.",
+		)
+	}
+	if !config.Type.IsNull() {
+		if config.AccessEndpoint.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("type"),
+				"Invalid Attribute Combination",
+				"type can only be set with access_endpoint. The type of deployment_id is read from the API.",
+			)
+		} else if t := config.Type.ValueString(); !config.Type.IsUnknown() && t != vmcloudapi.DeploymentTypeSingleNode.String() && t != vmcloudapi.DeploymentTypeCluster.String() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("type"),
+				"Invalid Deployment Type",
+				fmt.Sprintf("Expected one of: single_node, cluster. Got: %q", t),
+			)
+		}
+	}
+
+	if !config.Query.IsNull() && !config.Query.IsUnknown() {
+		if _, err := metricsql.Parse(config.Query.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("query"),
+				"Invalid Query",
+				fmt.Sprintf("Could not parse MetricsQL expression: %s", err.Error()),
+			)
+		}
+	}
+
+	if !config.Time.IsNull() && !(config.Start.IsNull() && config.End.IsNull() && config.Step.IsNull()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("time"),
+			"Invalid Attribute Combination",
+			"time cannot be combined with start, end or step.",
+		)
+	}
+	if config.Start.IsNull() && !(config.End.IsNull() && config.Step.IsNull()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("start"),
+			"Missing Attribute",
+			"start must be set to run a range query with end or step.",
+		)
+	}
+	for name, value := range map[string]types.String{"time": config.Time, "start": config.Start, "end": config.End} {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Time",
+				fmt.Sprintf("Expected a time in RFC 3339 format. Got: %q", value.ValueString()),
+			)
+		}
+	}
+	if !config.Step.IsNull() && !config.Step.IsUnknown() && !isQueryStep(config.Step.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("step"),
+			"Invalid Step",
+			fmt.Sprintf("Expected a duration such as 30s or 1m, or a number of seconds such as 60. Got: %q", config.Step.ValueString()),
+		)
+	}
+}
+
+// isQueryStep reports whether step is a duration or a positive number of seconds, as accepted by the query API.
+func isQueryStep(step string) bool {
+	if promDurationRe.MatchString(step) {
+		return true
+	}
+	seconds, err := strconv.ParseFloat(step, 64)
+	return err == nil && seconds > 0 && !math.IsInf(seconds, 0)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *queryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state queryDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accessEndpoint := state.AccessEndpoint.ValueString()
+	deploymentType := vmcloudapi.DeploymentTypeSingleNode
+	if !state.Type.IsNull() {
+		deploymentType = vmcloudapi.DeploymentType(state.Type.ValueString())
+	}
+	if !state.DeploymentID.IsNull() {
+		deployment, err := d.client.GetDeploymentDetails(ctx, state.DeploymentID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Deployment",
+				err.Error(),
+			)
+			return
+		}
+		accessEndpoint = deployment.AccessEndpoint
+		deploymentType = deployment.Type
+	}
+	endpoints := newDeploymentEndpoints(accessEndpoint, deploymentType, state.TenantID.ValueString())
+
+	queryURL := endpoints.Query + "/api/v1/query"
+	params := url.Values{"query": []string{state.Query.ValueString()}}
+	if !state.Start.IsNull() {
+		queryURL = endpoints.Query + "/api/v1/query_range"
+		params.Set("start", state.Start.ValueString())
+		end := time.Now().UTC().Format(time.RFC3339)
+		if !state.End.IsNull() {
+			end = state.End.ValueString()
+		}
+		params.Set("end", end)
+		if !state.Step.IsNull() {
+			params.Set("step", state.Step.ValueString())
+		}
+	} else if !state.Time.IsNull() {
+		params.Set("time", state.Time.ValueString())
+	}
+
+	var data queryData
+	if err := newDeploymentClient(state.AccessToken.ValueString()).getPromAPI(ctx, queryURL, params, &data); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Run Query",
+			err.Error(),
+		)
+		return
+	}
+
+	results, err := data.results()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Decode Query Result",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	state.ResultType = types.StringValue(data.ResultType)
+	state.Results = results
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// results decodes the query result into series.
+func (d queryData) results() ([]queryResultModel, error) {
+	results := []queryResultModel{}
+	switch d.ResultType {
+	case "vector", "matrix":
+		var series []querySeries
+		if err := json.Unmarshal(d.Result, &series); err != nil {
+			return nil, fmt.Errorf("invalid %s result: %w", d.ResultType, err)
+		}
+		for _, s := range series {
+			pairs := s.Values
+			if d.ResultType == "vector" {
+				pairs = [][]json.RawMessage{s.Value}
+			}
+			result := queryResultModel{Labels: s.Metric, Samples: []querySampleModel{}}
+			if result.Labels == nil {
+				result.Labels = map[string]string{}
+			}
+			for _, pair := range pairs {
+				sample, err := parseQuerySample(pair)
+				if err != nil {
+					return nil, err
+				}
+				result.Samples = append(result.Samples, sample)
+			}
+			results = append(results, result)
+		}
+	case "scalar":
+		var pair []json.RawMessage
+		if err := json.Unmarshal(d.Result, &pair); err != nil {
+			return nil, fmt.Errorf("invalid scalar result: %w", err)
+		}
+		sample, err := parseQuerySample(pair)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, queryResultModel{Labels: map[string]string{}, Samples: []querySampleModel{sample}})
+	default:
+		return nil, fmt.Errorf("unsupported result type %q", d.ResultType)
+	}
+	return results, nil
+}
+
+// parseQuerySample decodes a [timestamp, "value"] pair. Non-finite values are mapped to null.
+func parseQuerySample(pair []json.RawMessage) (querySampleModel, error) {
+	if len(pair) != 2 {
+		return querySampleModel{}, fmt.Errorf("invalid sample: expected a [timestamp, value] pair")
+	}
+
+	var timestamp float64
+	if err := json.Unmarshal(pair[0], &timestamp); err != nil {
+		return querySampleModel{}, fmt.Errorf("invalid sample timestamp: %w", err)
+	}
+	var raw string
+	if err := json.Unmarshal(pair[1], &raw); err != nil {
+		return querySampleModel{}, fmt.Errorf("invalid sample value: %w", err)
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return querySampleModel{}, fmt.Errorf("invalid sample value %q: %w", raw, err)
+	}
+
+	sample := querySampleModel{Timestamp: types.Float64Value(timestamp), Value: types.Float64Null()}
+	if !math.IsNaN(value) && !math.IsInf(value, 0) {
+		sample.Value = types.Float64Value(value)
+	}
+	return sample, nil
+}
@@ -28,7 +28,8 @@ type regionsDataSource struct {
 
 // regionsDataSourceModel maps the data source schema data.
 type regionsDataSourceModel struct {
-	Regions []regionModel `tfsdk:"regions"`
+	CloudProvider types.String  `tfsdk:"cloud_provider"`
+	Regions       []regionModel `tfsdk:"regions"`
 }
 
 // regionModel maps region data.
@@ -47,6 +48,10 @@ func (d *regionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 	resp.Schema = schema.Schema{
 		Description: "Fetches the list of available regions for VictoriaMetrics Cloud deployments.",
 		Attributes: map[string]schema.Attribute{
+			"cloud_provider": schema.StringAttribute{
+				Description: "Only return regions of this cloud provider.",
+				Optional:    true,
+			},
 			"regions": schema.ListNestedAttribute{
 				Description: "List of available regions.",
 				Computed:    true,
@@ -89,6 +94,12 @@ func (d *regionsDataSource) Configure(_ context.Context, req datasource.Configur
 func (d *regionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state regionsDataSourceModel
 
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	regions, err := d.client.ListRegions(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -99,7 +110,11 @@ func (d *regionsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	// Map response to state
+	state.Regions = []regionModel{}
 	for _, region := range regions {
+		if !state.CloudProvider.IsNull() && region.CloudProvider.String() != state.CloudProvider.ValueString() {
+			continue
+		}
 		regionState := regionModel{
 			Name:          types.StringValue(region.Name),
 			CloudProvider: types.StringValue(region.CloudProvider.String()),
@@ -108,6 +123,6 @@ func (d *regionsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
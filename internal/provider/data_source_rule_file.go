@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	vmcloudapi "github.com/VictoriaMetrics/victoriametrics-cloud-api-go/v1"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ruleFileDataSource{}
+	_ datasource.DataSourceWithConfigure = &ruleFileDataSource{}
+)
+
+// NewRuleFileDataSource is a helper function to simplify the provider implementation.
+func NewRuleFileDataSource() datasource.DataSource {
+	return &ruleFileDataSource{}
+}
+
+// ruleFileDataSource is the data source implementation.
+type ruleFileDataSource struct {
+	client *vmcloudapi.VMCloudAPIClient
+}
+
+// ruleFileDataSourceModel maps the data source schema data.
+type ruleFileDataSourceModel struct {
+	DeploymentID types.String `tfsdk:"deployment_id"`
+	FileName     types.String `tfsdk:"file_name"`
+	Content      types.String `tfsdk:"content"`
+	GroupCount   types.Int64  `tfsdk:"group_count"`
+	RuleCount    types.Int64  `tfsdk:"rule_count"`
+}
+
+// Metadata returns the data source type name.
+func (d *ruleFileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rule_file"
+}
+
+// Schema defines the schema for the data source.
+func (d *ruleFileDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the content of an alerting or recording rules file of a VictoriaMetrics Cloud deployment.",
+		Attributes: map[string]schema.Attribute{
+			"deployment_id": schema.StringAttribute{
+				Description: "ID of the deployment the rule file belongs to.",
+				Required:    true,
+			},
+			"file_name": schema.StringAttribute{
+				Description: "Name of the rule file.",
+				Required:    true,
+			},
+			"content": schema.StringAttribute{
+				Description: "YAML content of the rule file.",
+				Computed:    true,
+			},
+			"group_count": schema.Int64Attribute{
+				Description: "Number of rule groups in the file. Not set if the file cannot be parsed.",
+				Computed:    true,
+			},
+			"rule_count": schema.Int64Attribute{
+				Description: "Number of rules across all groups of the file. Not set if the file cannot be parsed.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ruleFileDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*vmcloudapi.VMCloudAPIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *vmcloudapi.VMCloudAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ruleFileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ruleFileDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := d.client.GetDeploymentRuleFileContent(ctx, state.DeploymentID.ValueString(), state.FileName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Rule File",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	state.Content = types.StringValue(content)
+	state.GroupCount = types.Int64Null()
+	state.RuleCount = types.Int64Null()
+	if file, err := parseRuleFile(content); err == nil {
+		state.GroupCount = types.Int64Value(int64(len(file.Groups)))
+		state.RuleCount = types.Int64Value(int64(file.ruleCount()))
+	} else {
+		resp.Diagnostics.AddWarning(
+			"Unable to Parse Rule File",
+			fmt.Sprintf("Could not parse rule file %s, group and rule counts are not set: %s", state.FileName.ValueString(), err.Error()),
+		)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"sync"
+
+	vmcloudapi "github.com/VictoriaMetrics/victoriametrics-cloud-api-go/v1"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/sync/errgroup"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ruleFilesDataSource{}
+	_ datasource.DataSourceWithConfigure = &ruleFilesDataSource{}
+)
+
+// defaultRuleFilesConcurrency is the default number of rule files fetched in parallel.
+const defaultRuleFilesConcurrency = 4
+
+// NewRuleFilesDataSource is a helper function to simplify the provider implementation.
+func NewRuleFilesDataSource() datasource.DataSource {
+	return &ruleFilesDataSource{}
+}
+
+// ruleFilesDataSource is the data source implementation.
+type ruleFilesDataSource struct {
+	client *vmcloudapi.VMCloudAPIClient
+}
+
+// ruleFilesDataSourceModel maps the data source schema data.
+type ruleFilesDataSourceModel struct {
+	DeploymentID   types.String         `tfsdk:"deployment_id"`
+	NameFilter     types.String         `tfsdk:"name_filter"`
+	IncludeContent types.Bool           `tfsdk:"include_content"`
+	MaxConcurrency types.Int64          `tfsdk:"max_concurrency"`
+	Files          []ruleFileEntryModel `tfsdk:"files"`
+}
+
+// ruleFileEntryModel maps rule file data.
+type ruleFileEntryModel struct {
+	FileName   types.String `tfsdk:"file_name"`
+	Content    types.String `tfsdk:"content"`
+	GroupCount types.Int64  `tfsdk:"group_count"`
+	RuleCount  types.Int64  `tfsdk:"rule_count"`
+}
+
+// Metadata returns the data source type name.
+func (d *ruleFilesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rule_files"
+}
+
+// Schema defines the schema for the data source.
+func (d *ruleFilesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the list of alerting and recording rule files of a VictoriaMetrics Cloud deployment, including files not managed by Terraform.",
+		Attributes: map[string]schema.Attribute{
+			"deployment_id": schema.StringAttribute{
+				Description: "ID of the deployment to list rule files for.",
+				Required:    true,
+			},
+			"name_filter": schema.StringAttribute{
+				Description: "Glob pattern the file names must match (e.g., 'alerts-*.yaml').",
+				Optional:    true,
+			},
+			"include_content": schema.BoolAttribute{
+				Description: "Whether to fetch the content of every file and count its groups and rules. Defaults to false.",
+				Optional:    true,
+			},
+			"max_concurrency": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of files fetched in parallel when include_content is set. Defaults to %d.", defaultRuleFilesConcurrency),
+				Optional:    true,
+			},
+			"files": schema.ListNestedAttribute{
+				Description: "List of rule files, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"file_name": schema.StringAttribute{
+							Description: "Name of the rule file.",
+							Computed:    true,
+						},
+						"content": schema.StringAttribute{
+							Description: "YAML content of the rule file. Only set when include_content is true.",
+							Computed:    true,
+						},
+						"group_count": schema.Int64Attribute{
+							Description: "Number of rule groups in the file. Only set when include_content is true and the file can be parsed.",
+							Computed:    true,
+						},
+						"rule_count": schema.Int64Attribute{
+							Description: "Number of rules across all groups of the file. Only set when include_content is true and the file can be parsed.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ruleFilesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*vmcloudapi.VMCloudAPIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *vmcloudapi.VMCloudAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ruleFilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ruleFilesDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := path.Match(state.NameFilter.ValueString(), ""); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Name Filter",
+			fmt.Sprintf("Could not parse name_filter %q: %s", state.NameFilter.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	names, err := d.client.ListDeploymentRuleFileNames(ctx, state.DeploymentID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Rule Files",
+			err.Error(),
+		)
+		return
+	}
+
+	if !state.NameFilter.IsNull() {
+		names = slices.DeleteFunc(names, func(name string) bool {
+			matched, _ := path.Match(state.NameFilter.ValueString(), name)
+			return !matched
+		})
+	}
+	slices.Sort(names)
+
+	var contents map[string]string
+	if state.IncludeContent.ValueBool() {
+		concurrency := defaultRuleFilesConcurrency
+		if !state.MaxConcurrency.IsNull() {
+			concurrency = int(state.MaxConcurrency.ValueInt64())
+		}
+		contents, err = fetchRuleFiles(ctx, d.client, state.DeploymentID.ValueString(), names, concurrency)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Rule Files",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	// Map response to state
+	state.Files = []ruleFileEntryModel{}
+	for _, name := range names {
+		fileState := ruleFileEntryModel{
+			FileName:   types.StringValue(name),
+			Content:    types.StringNull(),
+			GroupCount: types.Int64Null(),
+			RuleCount:  types.Int64Null(),
+		}
+		if content, ok := contents[name]; ok {
+			fileState.Content = types.StringValue(content)
+			if file, err := parseRuleFile(content); err == nil {
+				fileState.GroupCount = types.Int64Value(int64(len(file.Groups)))
+				fileState.RuleCount = types.Int64Value(int64(file.ruleCount()))
+			} else {
+				resp.Diagnostics.AddWarning(
+					"Unable to Parse Rule File",
+					fmt.Sprintf("Could not parse rule file %s, group and rule counts are not set: %s", name, err.Error()),
+				)
+			}
+		}
+		state.Files = append(state.Files, fileState)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// fetchRuleFiles fetches the content of the named rule files, at most concurrency at a time.
+func fetchRuleFiles(ctx context.Context, client *vmcloudapi.VMCloudAPIClient, deploymentID string, names []string, concurrency int) (map[string]string, error) {
+	var mu sync.Mutex
+	contents := make(map[string]string, len(names))
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(max(concurrency, 1))
+	for _, name := range names {
+		g.Go(func() error {
+			content, err := client.GetDeploymentRuleFileContent(ctx, deploymentID, name)
+			if err != nil {
+				return fmt.Errorf("failed to read rule file %q: %w", name, err)
+			}
+			mu.Lock()
+			contents[name] = content
+			mu.Unlock()
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return contents, nil
+}
@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	vmcloudapi "github.com/VictoriaMetrics/victoriametrics-cloud-api-go/v1"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &tierRecommendationDataSource{}
+	_ datasource.DataSourceWithConfigure = &tierRecommendationDataSource{}
+)
+
+// NewTierRecommendationDataSource is a helper function to simplify the provider implementation.
+func NewTierRecommendationDataSource() datasource.DataSource {
+	return &tierRecommendationDataSource{}
+}
+
+// tierRecommendationDataSource is the data source implementation.
+type tierRecommendationDataSource struct {
+	client *vmcloudapi.VMCloudAPIClient
+}
+
+// tierRecommendationDataSourceModel maps the data source schema data.
+type tierRecommendationDataSourceModel struct {
+	Type               types.String       `tfsdk:"type"`
+	CloudProvider      types.String       `tfsdk:"cloud_provider"`
+	IngestionRate      types.Int64        `tfsdk:"ingestion_rate"`
+	ActiveTimeSeries   types.Int64        `tfsdk:"active_time_series"`
+	NewSeriesOver24h   types.Int64        `tfsdk:"new_series_over_24h"`
+	DatapointsReadRate types.Int64        `tfsdk:"datapoints_read_rate"`
+	SeriesReadPerQuery types.Int64        `tfsdk:"series_read_per_query"`
+	Tier               *tierModel         `tfsdk:"tier"`
+	Headroom           *tierHeadroomModel `tfsdk:"headroom"`
+}
+
+// tierHeadroomModel maps the unused share of every tier limit, in percent.
+type tierHeadroomModel struct {
+	IngestionRate      types.Float64 `tfsdk:"ingestion_rate"`
+	ActiveTimeSeries   types.Float64 `tfsdk:"active_time_series"`
+	NewSeriesOver24h   types.Float64 `tfsdk:"new_series_over_24h"`
+	DatapointsReadRate types.Float64 `tfsdk:"datapoints_read_rate"`
+	SeriesReadPerQuery types.Float64 `tfsdk:"series_read_per_query"`
+}
+
+// Metadata returns the data source type name.
+func (d *tierRecommendationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tier_recommendation"
+}
+
+// Schema defines the schema for the data source.
+func (d *tierRecommendationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	requirement := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Description: description + " Not checked if unset.",
+			Optional:    true,
+		}
+	}
+	headroom := func(description string) schema.Float64Attribute {
+		return schema.Float64Attribute{
+			Description: description + " Null if the requirement is unset.",
+			Computed:    true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Recommends the cheapest VictoriaMetrics Cloud tier, by compute cost per hour, that satisfies the given capacity requirements.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "Only consider tiers of this deployment type (single_node or cluster).",
+				Optional:    true,
+			},
+			"cloud_provider": schema.StringAttribute{
+				Description: "Only consider tiers of this cloud provider.",
+				Optional:    true,
+			},
+			"ingestion_rate":        requirement("Required ingestion rate (samples per second)."),
+			"active_time_series":    requirement("Required number of active time series."),
+			"new_series_over_24h":   requirement("Required number of new series over 24 hours."),
+			"datapoints_read_rate":  requirement("Required datapoints read rate."),
+			"series_read_per_query": requirement("Required number of series read per query."),
+			"tier": schema.SingleNestedAttribute{
+				Description: "Cheapest tier satisfying all requirements.",
+				Computed:    true,
+				Attributes:  tierAttributes(),
+			},
+			"headroom": schema.SingleNestedAttribute{
+				Description: "Share of every limit of the recommended tier left unused by the requirements, in percent.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"ingestion_rate":        headroom("Ingestion rate headroom."),
+					"active_time_series":    headroom("Active time series headroom."),
+					"new_series_over_24h":   headroom("New series over 24 hours headroom."),
+					"datapoints_read_rate":  headroom("Datapoints read rate headroom."),
+					"series_read_per_query": headroom("Series read per query headroom."),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *tierRecommendationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*vmcloudapi.VMCloudAPIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *vmcloudapi.VMCloudAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *tierRecommendationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state tierRecommendationDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tiers, err := d.client.ListTiers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tiers",
+			err.Error(),
+		)
+		return
+	}
+
+	candidates := slices.DeleteFunc(filterTiers(tiers, state.Type, state.CloudProvider), func(tier vmcloudapi.TierInfo) bool {
+		return !state.satisfiedBy(tier)
+	})
+	if len(candidates) == 0 {
+		resp.Diagnostics.AddError(
+			"No Matching Tier",
+			"None of the available tiers matching the type and cloud provider filters satisfies all of the requirements.",
+		)
+		return
+	}
+
+	recommended := slices.MinFunc(candidates, func(a, b vmcloudapi.TierInfo) int {
+		return cmp.Or(cmp.Compare(a.ComputeCostPerHour, b.ComputeCostPerHour), cmp.Compare(a.ID, b.ID))
+	})
+
+	// Map response to state
+	tier := newTierModel(recommended)
+	state.Tier = &tier
+	state.Headroom = &tierHeadroomModel{
+		IngestionRate:      headroomPercent(state.IngestionRate, recommended.IngestionRate),
+		ActiveTimeSeries:   headroomPercent(state.ActiveTimeSeries, recommended.ActiveTimeSeries),
+		NewSeriesOver24h:   headroomPercent(state.NewSeriesOver24h, recommended.NewSeriesOver24h),
+		DatapointsReadRate: headroomPercent(state.DatapointsReadRate, recommended.DatapointsReadRate),
+		SeriesReadPerQuery: headroomPercent(state.SeriesReadPerQuery, recommended.SeriesReadPerQuery),
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// satisfiedBy reports whether every set requirement fits into the limits of the tier.
+func (m tierRecommendationDataSourceModel) satisfiedBy(tier vmcloudapi.TierInfo) bool {
+	for _, limit := range []struct {
+		required types.Int64
+		limit    int
+	}{
+		{m.IngestionRate, tier.IngestionRate},
+		{m.ActiveTimeSeries, tier.ActiveTimeSeries},
+		{m.NewSeriesOver24h, tier.NewSeriesOver24h},
+		{m.DatapointsReadRate, tier.DatapointsReadRate},
+		{m.SeriesReadPerQuery, tier.SeriesReadPerQuery},
+	} {
+		if !limit.required.IsNull() && limit.required.ValueInt64() > int64(limit.limit) {
+			return false
+		}
+	}
+	return true
+}
+
+// headroomPercent returns the share of limit left unused by required, in percent.
+func headroomPercent(required types.Int64, limit int) types.Float64 {
+	if required.IsNull() || limit <= 0 {
+		return types.Float64Null()
+	}
+	return types.Float64Value(float64(int64(limit)-required.ValueInt64()) / float64(limit) * 100)
+}
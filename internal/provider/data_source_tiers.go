@@ -28,7 +28,9 @@ type tiersDataSource struct {
 
 // tiersDataSourceModel maps the data source schema data.
 type tiersDataSourceModel struct {
-	Tiers []tierModel `tfsdk:"tiers"`
+	Type          types.String `tfsdk:"type"`
+	CloudProvider types.String `tfsdk:"cloud_provider"`
+	Tiers         []tierModel  `tfsdk:"tiers"`
 }
 
 // tierModel maps tier data.
@@ -56,62 +58,75 @@ func (d *tiersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 	resp.Schema = schema.Schema{
 		Description: "Fetches the list of available tiers for VictoriaMetrics Cloud deployments.",
 		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "Only return tiers of this deployment type (single_node or cluster).",
+				Optional:    true,
+			},
+			"cloud_provider": schema.StringAttribute{
+				Description: "Only return tiers of this cloud provider.",
+				Optional:    true,
+			},
 			"tiers": schema.ListNestedAttribute{
 				Description: "List of available tiers.",
 				Computed:    true,
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.Int64Attribute{
-							Description: "Unique identifier of the tier.",
-							Computed:    true,
-						},
-						"type": schema.StringAttribute{
-							Description: "Type of deployment (single_node or cluster).",
-							Computed:    true,
-						},
-						"cloud_provider": schema.StringAttribute{
-							Description: "Cloud provider for this tier.",
-							Computed:    true,
-						},
-						"name": schema.StringAttribute{
-							Description: "Name of the tier.",
-							Computed:    true,
-						},
-						"compute_cost_per_hour": schema.Float64Attribute{
-							Description: "Compute cost per hour in USD.",
-							Computed:    true,
-						},
-						"ingestion_rate": schema.Int64Attribute{
-							Description: "Maximum ingestion rate (samples per second).",
-							Computed:    true,
-						},
-						"active_time_series": schema.Int64Attribute{
-							Description: "Maximum number of active time series.",
-							Computed:    true,
-						},
-						"new_series_over_24h": schema.Int64Attribute{
-							Description: "Maximum number of new series over 24 hours.",
-							Computed:    true,
-						},
-						"datapoints_read_rate": schema.Int64Attribute{
-							Description: "Maximum datapoints read rate.",
-							Computed:    true,
-						},
-						"series_read_per_query": schema.Int64Attribute{
-							Description: "Maximum series read per query.",
-							Computed:    true,
-						},
-						"access_token_concurrent_requests": schema.Int64Attribute{
-							Description: "Maximum concurrent requests per access token.",
-							Computed:    true,
-						},
-					},
+					Attributes: tierAttributes(),
 				},
 			},
 		},
 	}
 }
 
+// tierAttributes returns the schema attributes of a tier.
+func tierAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "Unique identifier of the tier.",
+			Computed:    true,
+		},
+		"type": schema.StringAttribute{
+			Description: "Type of deployment (single_node or cluster).",
+			Computed:    true,
+		},
+		"cloud_provider": schema.StringAttribute{
+			Description: "Cloud provider for this tier.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the tier.",
+			Computed:    true,
+		},
+		"compute_cost_per_hour": schema.Float64Attribute{
+			Description: "Compute cost per hour in USD.",
+			Computed:    true,
+		},
+		"ingestion_rate": schema.Int64Attribute{
+			Description: "Maximum ingestion rate (samples per second).",
+			Computed:    true,
+		},
+		"active_time_series": schema.Int64Attribute{
+			Description: "Maximum number of active time series.",
+			Computed:    true,
+		},
+		"new_series_over_24h": schema.Int64Attribute{
+			Description: "Maximum number of new series over 24 hours.",
+			Computed:    true,
+		},
+		"datapoints_read_rate": schema.Int64Attribute{
+			Description: "Maximum datapoints read rate.",
+			Computed:    true,
+		},
+		"series_read_per_query": schema.Int64Attribute{
+			Description: "Maximum series read per query.",
+			Computed:    true,
+		},
+		"access_token_concurrent_requests": schema.Int64Attribute{
+			Description: "Maximum concurrent requests per access token.",
+			Computed:    true,
+		},
+	}
+}
+
 // Configure adds the provider configured client to the data source.
 func (d *tiersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -134,6 +149,12 @@ func (d *tiersDataSource) Configure(_ context.Context, req datasource.ConfigureR
 func (d *tiersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state tiersDataSourceModel
 
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tiers, err := d.client.ListTiers(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -144,24 +165,44 @@ func (d *tiersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Map response to state
-	for _, tier := range tiers {
-		tierState := tierModel{
-			ID:                            types.Int64Value(int64(tier.ID)),
-			Type:                          types.StringValue(tier.Type.String()),
-			CloudProvider:                 types.StringValue(tier.CloudProvider.String()),
-			Name:                          types.StringValue(tier.Name),
-			ComputeCostPerHour:            types.Float64Value(tier.ComputeCostPerHour),
-			IngestionRate:                 types.Int64Value(int64(tier.IngestionRate)),
-			ActiveTimeSeries:              types.Int64Value(int64(tier.ActiveTimeSeries)),
-			NewSeriesOver24h:              types.Int64Value(int64(tier.NewSeriesOver24h)),
-			DatapointsReadRate:            types.Int64Value(int64(tier.DatapointsReadRate)),
-			SeriesReadPerQuery:            types.Int64Value(int64(tier.SeriesReadPerQuery)),
-			AccessTokenConcurrentRequests: types.Int64Value(int64(tier.AccessTokenConcurrentRequests)),
-		}
-		state.Tiers = append(state.Tiers, tierState)
+	state.Tiers = []tierModel{}
+	for _, tier := range filterTiers(tiers, state.Type, state.CloudProvider) {
+		state.Tiers = append(state.Tiers, newTierModel(tier))
 	}
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
+
+// filterTiers returns the tiers matching the deployment type and cloud provider filters, if set.
+func filterTiers(tiers vmcloudapi.TierInfoList, deploymentType, cloudProvider types.String) vmcloudapi.TierInfoList {
+	var filtered vmcloudapi.TierInfoList
+	for _, tier := range tiers {
+		if !deploymentType.IsNull() && tier.Type.String() != deploymentType.ValueString() {
+			continue
+		}
+		if !cloudProvider.IsNull() && tier.CloudProvider.String() != cloudProvider.ValueString() {
+			continue
+		}
+		filtered = append(filtered, tier)
+	}
+	return filtered
+}
+
+// newTierModel maps tier data.
+func newTierModel(tier vmcloudapi.TierInfo) tierModel {
+	return tierModel{
+		ID:                            types.Int64Value(int64(tier.ID)),
+		Type:                          types.StringValue(tier.Type.String()),
+		CloudProvider:                 types.StringValue(tier.CloudProvider.String()),
+		Name:                          types.StringValue(tier.Name),
+		ComputeCostPerHour:            types.Float64Value(tier.ComputeCostPerHour),
+		IngestionRate:                 types.Int64Value(int64(tier.IngestionRate)),
+		ActiveTimeSeries:              types.Int64Value(int64(tier.ActiveTimeSeries)),
+		NewSeriesOver24h:              types.Int64Value(int64(tier.NewSeriesOver24h)),
+		DatapointsReadRate:            types.Int64Value(int64(tier.DatapointsReadRate)),
+		SeriesReadPerQuery:            types.Int64Value(int64(tier.SeriesReadPerQuery)),
+		AccessTokenConcurrentRequests: types.Int64Value(int64(tier.AccessTokenConcurrentRequests)),
+	}
+}
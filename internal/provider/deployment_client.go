@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// deploymentRequestTimeout bounds every request to the data plane of a deployment.
+const deploymentRequestTimeout = 30 * time.Second
+
+// deploymentClient performs requests against the data plane of a deployment, authenticated with an access token.
+type deploymentClient struct {
+	httpClient *http.Client
+	token      string
+}
+
+// newDeploymentClient returns a client authenticating its requests with the given access token.
+func newDeploymentClient(token string) *deploymentClient {
+	return &deploymentClient{
+		httpClient: &http.Client{Timeout: deploymentRequestTimeout},
+		token:      token,
+	}
+}
+
+// do sends a request to rawURL and returns the response body. Responses with a non-2xx status code are errors.
+func (c *deploymentClient) do(ctx context.Context, method, rawURL string, query url.Values, body io.Reader, contentType string) ([]byte, error) {
+	if len(query) > 0 {
+		rawURL += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %w", method, redactURL(rawURL), err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: failed to read response: %w", method, redactURL(rawURL), err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("%s %s: unexpected status code %d: %s", method, redactURL(rawURL), resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	return data, nil
+}
+
+// get sends a GET request to rawURL and returns the response body.
+func (c *deploymentClient) get(ctx context.Context, rawURL string, query url.Values) ([]byte, error) {
+	return c.do(ctx, http.MethodGet, rawURL, query, nil, "")
+}
+
+// promAPIResponse maps the envelope of Prometheus querying API responses.
+type promAPIResponse struct {
+	Status    string          `json:"status"`
+	Data      json.RawMessage `json:"data"`
+	ErrorType string          `json:"errorType"`
+	Error     string          `json:"error"`
+}
+
+// getPromAPI sends a GET request to a Prometheus querying API endpoint and decodes the data of the response into out.
+func (c *deploymentClient) getPromAPI(ctx context.Context, rawURL string, query url.Values, out any) error {
+	body, err := c.get(ctx, rawURL, query)
+	if err != nil {
+		return err
+	}
+
+	var resp promAPIResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return fmt.Errorf("GET %s: invalid response: %w", redactURL(rawURL), err)
+	}
+	if resp.Status != "success" {
+		return fmt.Errorf("GET %s: %s: %s", redactURL(rawURL), resp.ErrorType, resp.Error)
+	}
+	if err := json.Unmarshal(resp.Data, out); err != nil {
+		return fmt.Errorf("GET %s: invalid response data: %w", redactURL(rawURL), err)
+	}
+	return nil
+}
+
+// redactURL strips the query string from rawURL, so that errors do not leak query parameters.
+func redactURL(rawURL string) string {
+	base, _, _ := strings.Cut(rawURL, "?")
+	return base
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	vmcloudapi "github.com/VictoriaMetrics/victoriametrics-cloud-api-go/v1"
+)
+
+// deploymentNamePrefix marks a deployment reference that contains a deployment name instead of its ID.
+const deploymentNamePrefix = "name:"
+
+// resolveDeploymentID returns the ID of the deployment referenced by ref.
+// The reference is either a raw deployment ID or "name:<deployment name>".
+func resolveDeploymentID(ctx context.Context, client *vmcloudapi.VMCloudAPIClient, ref string) (string, error) {
+	name, ok := strings.CutPrefix(ref, deploymentNamePrefix)
+	if !ok {
+		return ref, nil
+	}
+
+	deployment, err := findDeploymentByName(ctx, client, name)
+	if err != nil {
+		return "", err
+	}
+
+	return deployment.ID, nil
+}
+
+// findDeploymentByName looks up the only deployment with the given name.
+func findDeploymentByName(ctx context.Context, client *vmcloudapi.VMCloudAPIClient, name string) (vmcloudapi.DeploymentSummary, error) {
+	if name == "" {
+		return vmcloudapi.DeploymentSummary{}, fmt.Errorf("deployment name cannot be empty")
+	}
+
+	deployments, err := client.ListDeployments(ctx)
+	if err != nil {
+		return vmcloudapi.DeploymentSummary{}, fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	var matches []vmcloudapi.DeploymentSummary
+	for _, deployment := range deployments {
+		if deployment.Name == name {
+			matches = append(matches, deployment)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return vmcloudapi.DeploymentSummary{}, fmt.Errorf("no deployment found with name %q", name)
+	case 1:
+		return matches[0], nil
+	default:
+		ids := make([]string, 0, len(matches))
+		for _, deployment := range matches {
+			ids = append(ids, deployment.ID)
+		}
+		return vmcloudapi.DeploymentSummary{}, fmt.Errorf(
+			"found %d deployments with name %q (IDs: %s), use the deployment ID instead",
+			len(matches), name, strings.Join(ids, ", "),
+		)
+	}
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"strings"
+
+	vmcloudapi "github.com/VictoriaMetrics/victoriametrics-cloud-api-go/v1"
+)
+
+// defaultTenantID is the tenant used for cluster deployments when no tenant is specified.
+const defaultTenantID = "0"
+
+// deploymentEndpoints holds the data plane URLs of a deployment.
+type deploymentEndpoints struct {
+	// RemoteWrite is the Prometheus remote write URL.
+	RemoteWrite string
+	// Query is the base URL of the Prometheus querying API, suitable as a Grafana datasource URL.
+	Query string
+	// Import is the URL of the Prometheus text exposition format import API.
+	Import string
+}
+
+// newDeploymentEndpoints derives data plane URLs from the deployment access endpoint and type.
+// Cluster deployments use the tenant-specific insert and select paths, with tenant 0 by default.
+func newDeploymentEndpoints(accessEndpoint string, deploymentType vmcloudapi.DeploymentType, tenantID string) deploymentEndpoints {
+	base := deploymentBaseURL(accessEndpoint)
+	if deploymentType != vmcloudapi.DeploymentTypeCluster {
+		return deploymentEndpoints{
+			RemoteWrite: base + "/api/v1/write",
+			Query:       base,
+			Import:      base + "/api/v1/import/prometheus",
+		}
+	}
+
+	if tenantID == "" {
+		tenantID = defaultTenantID
+	}
+	return deploymentEndpoints{
+		RemoteWrite: base + "/insert/" + tenantID + "/prometheus/api/v1/write",
+		Query:       base + "/select/" + tenantID + "/prometheus",
+		Import:      base + "/insert/" + tenantID + "/prometheus/api/v1/import/prometheus",
+	}
+}
+
+// deploymentBaseURL returns the access endpoint of a deployment as a URL without a trailing slash,
+// defaulting to the https scheme.
+func deploymentBaseURL(accessEndpoint string) string {
+	base := strings.TrimSuffix(accessEndpoint, "/")
+	if !strings.Contains(base, "://") {
+		base = "https://" + base
+	}
+	return base
+}
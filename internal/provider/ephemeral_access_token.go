@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	vmcloudapi "github.com/VictoriaMetrics/victoriametrics-cloud-api-go/v1"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ephemeralAccessTokenPrivateKey is the private data key holding the token to delete on Close.
+const ephemeralAccessTokenPrivateKey = "access_token"
+
+// defaultEphemeralAccessTokenDescription is the description of ephemeral access tokens, before the run tag.
+const defaultEphemeralAccessTokenDescription = "Terraform ephemeral access token"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &ephemeralAccessTokenResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &ephemeralAccessTokenResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &ephemeralAccessTokenResource{}
+)
+
+// NewEphemeralAccessTokenResource is a helper function to simplify the provider implementation.
+func NewEphemeralAccessTokenResource() ephemeral.EphemeralResource {
+	return &ephemeralAccessTokenResource{}
+}
+
+// ephemeralAccessTokenResource is the ephemeral resource implementation.
+type ephemeralAccessTokenResource struct {
+	client *vmcloudapi.VMCloudAPIClient
+}
+
+// ephemeralAccessTokenResourceModel maps the ephemeral resource schema data.
+type ephemeralAccessTokenResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	DeploymentID types.String `tfsdk:"deployment_id"`
+	Type         types.String `tfsdk:"type"`
+	Description  types.String `tfsdk:"description"`
+	TenantID     types.String `tfsdk:"tenant_id"`
+	RunID        types.String `tfsdk:"run_id"`
+	Secret       types.String `tfsdk:"secret"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+}
+
+// ephemeralAccessTokenPrivate is the private data identifying the token to delete on Close.
+type ephemeralAccessTokenPrivate struct {
+	DeploymentID string `json:"deployment_id"`
+	TokenID      string `json:"token_id"`
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *ephemeralAccessTokenResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ephemeral_access_token"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *ephemeralAccessTokenResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a short-lived access token for a VictoriaMetrics Cloud deployment. " +
+			"The token is created when Terraform opens the ephemeral resource and deleted when Terraform closes it, " +
+			"so it never persists in state or plan files.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the access token.",
+				Computed:    true,
+			},
+			"deployment_id": schema.StringAttribute{
+				Description: "ID of the deployment the token belongs to.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Access mode of the token. Valid values: 'r' (read-only), 'w' (write-only), 'rw' (read-write).",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: fmt.Sprintf("Description of the access token, tagged with the run ID. Defaults to '%s'.", defaultEphemeralAccessTokenDescription),
+				Optional:    true,
+				Computed:    true,
+			},
+			"tenant_id": schema.StringAttribute{
+				Description: "Optional tenant ID for cluster deployments (format: accountID or accountID:projectID).",
+				Optional:    true,
+			},
+			"run_id": schema.StringAttribute{
+				Description: "Identifier of the run the token is created for, such as a CI job ID, appended to the description. " +
+					"Defaults to a random identifier, so leftover tokens of interrupted runs can be told apart.",
+				Optional: true,
+				Computed: true,
+			},
+			"secret": schema.StringAttribute{
+				Description: "Secret value of the access token.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp of token creation.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (r *ephemeralAccessTokenResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*vmcloudapi.VMCloudAPIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *vmcloudapi.VMCloudAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Open creates the access token.
+func (r *ephemeralAccessTokenResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeralAccessTokenResourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RunID.IsNull() {
+		runID, err := newRunID()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Generate Run ID",
+				err.Error(),
+			)
+			return
+		}
+		data.RunID = types.StringValue(runID)
+	}
+	description := defaultEphemeralAccessTokenDescription
+	if !data.Description.IsNull() {
+		description = data.Description.ValueString()
+	}
+	data.Description = types.StringValue(fmt.Sprintf("%s (run %s)", description, data.RunID.ValueString()))
+
+	// Create the access token
+	createRequest := vmcloudapi.AccessTokenCreateRequest{
+		Type:        vmcloudapi.AccessMode(data.Type.ValueString()),
+		Description: data.Description.ValueString(),
+	}
+	if !data.TenantID.IsNull() {
+		createRequest.TenantID = data.TenantID.ValueString()
+	}
+	token, err := r.client.CreateDeploymentAccessToken(ctx, data.DeploymentID.ValueString(), createRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating access token",
+			"Could not create access token, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Close only deletes tokens recorded in private data, so a token that cannot be recorded is deleted right away.
+	private, err := json.Marshal(ephemeralAccessTokenPrivate{DeploymentID: data.DeploymentID.ValueString(), TokenID: token.ID})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating access token",
+			"Could not record access token "+token.ID+" for deletion: "+err.Error(),
+		)
+		r.deleteUnrecorded(ctx, &resp.Diagnostics, data.DeploymentID.ValueString(), token.ID)
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, ephemeralAccessTokenPrivateKey, private)...)
+	if resp.Diagnostics.HasError() {
+		r.deleteUnrecorded(ctx, &resp.Diagnostics, data.DeploymentID.ValueString(), token.ID)
+		return
+	}
+
+	// Map response to result
+	data.ID = types.StringValue(token.ID)
+	data.Secret = types.StringValue(token.Secret)
+	data.CreatedAt = types.StringValue(token.CreatedAt.Format(time.RFC3339))
+
+	tflog.Trace(ctx, "created ephemeral access token", map[string]any{"id": token.ID, "deployment_id": data.DeploymentID.ValueString()})
+
+	diags = resp.Result.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Close deletes the access token.
+func (r *ephemeralAccessTokenResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	raw, diags := req.Private.GetKey(ctx, ephemeralAccessTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || raw == nil {
+		return
+	}
+
+	var private ephemeralAccessTokenPrivate
+	if err := json.Unmarshal(raw, &private); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting access token",
+			"Could not decode the access token to delete: "+err.Error(),
+		)
+		return
+	}
+
+	err := r.client.DeleteDeploymentAccessToken(ctx, private.DeploymentID, private.TokenID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting access token",
+			"Could not delete access token "+private.TokenID+" of deployment "+private.DeploymentID+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted ephemeral access token", map[string]any{"id": private.TokenID, "deployment_id": private.DeploymentID})
+}
+
+// deleteUnrecorded deletes a token created by Open that could not be recorded for deletion on Close.
+func (r *ephemeralAccessTokenResource) deleteUnrecorded(ctx context.Context, diags *diag.Diagnostics, deploymentID, tokenID string) {
+	err := r.client.DeleteDeploymentAccessToken(ctx, deploymentID, tokenID)
+	if err != nil {
+		diags.AddError(
+			"Error deleting access token",
+			"Could not delete access token "+tokenID+" of deployment "+deploymentID+", delete it manually. Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted ephemeral access token", map[string]any{"id": tokenID, "deployment_id": deploymentID})
+}
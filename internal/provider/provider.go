@@ -4,9 +4,14 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 
 	vmcloudapi "github.com/VictoriaMetrics/victoriametrics-cloud-api-go/v1"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,7 +20,10 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &victoriametricsCloudProvider{}
+	_ provider.Provider                       = &victoriametricsCloudProvider{}
+	_ provider.ProviderWithValidateConfig     = &victoriametricsCloudProvider{}
+	_ provider.ProviderWithActions            = &victoriametricsCloudProvider{}
+	_ provider.ProviderWithEphemeralResources = &victoriametricsCloudProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -37,8 +45,23 @@ type victoriametricsCloudProvider struct {
 
 // victoriametricsCloudProviderModel maps provider schema data to a Go type.
 type victoriametricsCloudProviderModel struct {
-	APIKey  types.String `tfsdk:"api_key"`
-	BaseURL types.String `tfsdk:"base_url"`
+	APIKey   types.String   `tfsdk:"api_key"`
+	BaseURL  types.String   `tfsdk:"base_url"`
+	RuleLint *ruleLintModel `tfsdk:"rule_lint"`
+}
+
+// ruleLintModel maps the rule_lint block data.
+type ruleLintModel struct {
+	MissingFor          types.String `tfsdk:"missing_for"`
+	MissingAnnotations  types.String `tfsdk:"missing_annotations"`
+	MissingSeverity     types.String `tfsdk:"missing_severity"`
+	RecordingRuleNaming types.String `tfsdk:"recording_rule_naming"`
+}
+
+// resourceData is the data shared with resources by the configured provider.
+type resourceData struct {
+	client   *vmcloudapi.VMCloudAPIClient
+	ruleLint ruleLintConfig
 }
 
 // Metadata returns the provider type name.
@@ -62,6 +85,59 @@ func (p *victoriametricsCloudProvider) Schema(_ context.Context, _ provider.Sche
 				Optional:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"rule_lint": schema.SingleNestedBlock{
+				Description: "Alerting hygiene checks applied to rule files and rule groups during planning. " +
+					"Every check can be set to off, warning or error, and defaults to off.",
+				Attributes: map[string]schema.Attribute{
+					"missing_for": schema.StringAttribute{
+						Description: "Severity of alerts without a for duration.",
+						Optional:    true,
+					},
+					"missing_annotations": schema.StringAttribute{
+						Description: "Severity of alerts without summary or description annotations.",
+						Optional:    true,
+					},
+					"missing_severity": schema.StringAttribute{
+						Description: "Severity of alerts without a severity label, on the alert or its group.",
+						Optional:    true,
+					},
+					"recording_rule_naming": schema.StringAttribute{
+						Description: "Severity of recording rule names that do not follow the level:metric:operation convention.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the severities of the rule lint checks.
+func (p *victoriametricsCloudProvider) ValidateConfig(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var config victoriametricsCloudProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.RuleLint == nil {
+		return
+	}
+
+	for _, check := range []struct {
+		name  string
+		value types.String
+	}{
+		{"missing_for", config.RuleLint.MissingFor},
+		{"missing_annotations", config.RuleLint.MissingAnnotations},
+		{"missing_severity", config.RuleLint.MissingSeverity},
+		{"recording_rule_naming", config.RuleLint.RecordingRuleNaming},
+	} {
+		value := check.value
+		if value.IsNull() || value.IsUnknown() || slices.Contains(ruleLintSeverities, value.ValueString()) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rule_lint").AtName(check.name),
+			"Invalid Rule Lint Severity",
+			fmt.Sprintf("Expected one of %s. Got: %q", strings.Join(ruleLintSeverities, ", "), value.ValueString()),
+		)
 	}
 }
 
@@ -115,9 +191,41 @@ func (p *victoriametricsCloudProvider) Configure(ctx context.Context, req provid
 		return
 	}
 
-	// Make the client available during DataSource and Resource type Configure methods.
+	// Make the client available during DataSource, Resource, EphemeralResource and Action type Configure methods.
 	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+	resp.ActionData = client
+	resp.ResourceData = &resourceData{
+		client:   client,
+		ruleLint: config.RuleLint.config(),
+	}
+}
+
+// config returns the rule lint configuration, with unset checks turned off.
+func (m *ruleLintModel) config() ruleLintConfig {
+	c := ruleLintConfig{
+		MissingFor:          ruleLintOff,
+		MissingAnnotations:  ruleLintOff,
+		MissingSeverity:     ruleLintOff,
+		RecordingRuleNaming: ruleLintOff,
+	}
+	if m == nil {
+		return c
+	}
+	for _, field := range []struct {
+		value  types.String
+		target *string
+	}{
+		{m.MissingFor, &c.MissingFor},
+		{m.MissingAnnotations, &c.MissingAnnotations},
+		{m.MissingSeverity, &c.MissingSeverity},
+		{m.RecordingRuleNaming, &c.RecordingRuleNaming},
+	} {
+		if !field.value.IsNull() && !field.value.IsUnknown() {
+			*field.target = field.value.ValueString()
+		}
+	}
+	return c
 }
 
 // DataSources defines the data sources implemented in the provider.
@@ -126,8 +234,15 @@ func (p *victoriametricsCloudProvider) DataSources(_ context.Context) []func() d
 		NewCloudProvidersDataSource,
 		NewRegionsDataSource,
 		NewTiersDataSource,
+		NewTierRecommendationDataSource,
+		NewPriceEstimateDataSource,
+		NewDeploymentHealthDataSource,
+		NewQueryDataSource,
 		NewDeploymentDataSource,
 		NewDeploymentsDataSource,
+		NewClientConfigDataSource,
+		NewRuleFileDataSource,
+		NewRuleFilesDataSource,
 	}
 }
 
@@ -137,5 +252,21 @@ func (p *victoriametricsCloudProvider) Resources(_ context.Context) []func() res
 		NewDeploymentResource,
 		NewAccessTokenResource,
 		NewRuleFileResource,
+		NewRuleGroupResource,
+		NewRuleFilesResource,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *victoriametricsCloudProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewEphemeralAccessTokenResource,
+	}
+}
+
+// Actions defines the actions implemented in the provider.
+func (p *victoriametricsCloudProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewSmokeTestAction,
 	}
 }
@@ -9,6 +9,7 @@ import (
 	vmcloudapi "github.com/VictoriaMetrics/victoriametrics-cloud-api-go/v1"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -21,6 +22,7 @@ var (
 	_ resource.Resource                = &accessTokenResource{}
 	_ resource.ResourceWithConfigure   = &accessTokenResource{}
 	_ resource.ResourceWithImportState = &accessTokenResource{}
+	_ resource.ResourceWithIdentity    = &accessTokenResource{}
 )
 
 // NewAccessTokenResource is a helper function to simplify the provider implementation.
@@ -46,6 +48,12 @@ type accessTokenResourceModel struct {
 	LastUsedAt   types.String `tfsdk:"last_used_at"`
 }
 
+// accessTokenIdentityModel maps the resource identity schema data.
+type accessTokenIdentityModel struct {
+	DeploymentID types.String `tfsdk:"deployment_id"`
+	TokenID      types.String `tfsdk:"token_id"`
+}
+
 // Metadata returns the resource type name.
 func (r *accessTokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_access_token"
@@ -118,24 +126,40 @@ func (r *accessTokenResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *accessTokenResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"deployment_id": identityschema.StringAttribute{
+				Description:       "ID of the deployment the token belongs to.",
+				RequiredForImport: true,
+			},
+			"token_id": identityschema.StringAttribute{
+				Description:       "Unique identifier of the access token.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *accessTokenResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*vmcloudapi.VMCloudAPIClient)
+	data, ok := req.ProviderData.(*resourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *vmcloudapi.VMCloudAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.resourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -179,6 +203,7 @@ func (r *accessTokenResource) Create(ctx context.Context, req resource.CreateReq
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, accessTokenIdentityModel{DeploymentID: plan.DeploymentID, TokenID: plan.ID})...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -218,6 +243,7 @@ func (r *accessTokenResource) Read(ctx context.Context, req resource.ReadRequest
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, accessTokenIdentityModel{DeploymentID: state.DeploymentID, TokenID: state.ID})...)
 }
 
 // Update is not supported for access tokens (requires replacement).
@@ -251,18 +277,44 @@ func (r *accessTokenResource) Delete(ctx context.Context, req resource.DeleteReq
 	tflog.Trace(ctx, "deleted access token", map[string]any{"id": state.ID.ValueString(), "deployment_id": state.DeploymentID.ValueString()})
 }
 
-// ImportState imports the resource state.
+// ImportState imports the resource state from an import identifier or a resource identity.
 func (r *accessTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Expected format: deployment_id/token_id
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+	if req.ID == "" {
+		var identity accessTokenIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deployment_id"), identity.DeploymentID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.TokenID)...)
+		return
+	}
+
+	// Expected format: deployment_id/token_id or name:deployment_name/token_id.
+	// Token IDs never contain slashes, so the identifier is split at the last one.
+	i := strings.LastIndex(req.ID, "/")
+	if i <= 0 || i == len(req.ID)-1 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import identifier with format: deployment_id/token_id or name:deployment_name/token_id. Got: %q", req.ID),
+		)
+		return
+	}
+	deploymentRef, tokenID := req.ID[:i], req.ID[i+1:]
+
+	deploymentID, err := resolveDeploymentID(ctx, r.client, deploymentRef)
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
-			fmt.Sprintf("Expected import identifier with format: deployment_id/token_id. Got: %q", req.ID),
+			fmt.Sprintf("Could not resolve deployment from import identifier %q: %s", req.ID, err.Error()),
 		)
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deployment_id"), parts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deployment_id"), deploymentID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), tokenID)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, accessTokenIdentityModel{
+		DeploymentID: types.StringValue(deploymentID),
+		TokenID:      types.StringValue(tokenID),
+	})...)
 }
@@ -8,6 +8,7 @@ import (
 	vmcloudapi "github.com/VictoriaMetrics/victoriametrics-cloud-api-go/v1"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -20,6 +21,7 @@ var (
 	_ resource.Resource                = &deploymentResource{}
 	_ resource.ResourceWithConfigure   = &deploymentResource{}
 	_ resource.ResourceWithImportState = &deploymentResource{}
+	_ resource.ResourceWithIdentity    = &deploymentResource{}
 )
 
 // NewDeploymentResource is a helper function to simplify the provider implementation.
@@ -57,6 +59,11 @@ type deploymentResourceModel struct {
 	AccessEndpoint    types.String `tfsdk:"access_endpoint"`
 }
 
+// deploymentIdentityModel maps the resource identity schema data.
+type deploymentIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
 // Metadata returns the resource type name.
 func (r *deploymentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_deployment"
@@ -174,24 +181,36 @@ func (r *deploymentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *deploymentResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "Unique identifier of the deployment.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *deploymentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*vmcloudapi.VMCloudAPIClient)
+	data, ok := req.ProviderData.(*resourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *vmcloudapi.VMCloudAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.resourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -239,6 +258,7 @@ func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, deploymentIdentityModel{ID: plan.ID})...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -288,6 +308,7 @@ func (r *deploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, deploymentIdentityModel{ID: state.ID})...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -384,8 +405,28 @@ func (r *deploymentResource) Delete(ctx context.Context, req resource.DeleteRequ
 	tflog.Trace(ctx, "deleted deployment", map[string]any{"id": state.ID.ValueString()})
 }
 
-// ImportState imports the resource state.
+// ImportState imports the resource state from an import identifier or a resource identity.
 func (r *deploymentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		var identity deploymentIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+		return
+	}
+
+	// Expected format: deployment_id or name:deployment_name
+	deploymentID, err := resolveDeploymentID(ctx, r.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Could not resolve deployment from import identifier %q: %s", req.ID, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), deploymentID)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, deploymentIdentityModel{ID: types.StringValue(deploymentID)})...)
 }
@@ -3,13 +3,17 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	vmcloudapi "github.com/VictoriaMetrics/victoriametrics-cloud-api-go/v1"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -17,9 +21,12 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &ruleFileResource{}
-	_ resource.ResourceWithConfigure   = &ruleFileResource{}
-	_ resource.ResourceWithImportState = &ruleFileResource{}
+	_ resource.Resource                   = &ruleFileResource{}
+	_ resource.ResourceWithConfigure      = &ruleFileResource{}
+	_ resource.ResourceWithImportState    = &ruleFileResource{}
+	_ resource.ResourceWithIdentity       = &ruleFileResource{}
+	_ resource.ResourceWithModifyPlan     = &ruleFileResource{}
+	_ resource.ResourceWithValidateConfig = &ruleFileResource{}
 )
 
 // NewRuleFileResource is a helper function to simplify the provider implementation.
@@ -29,15 +36,27 @@ func NewRuleFileResource() resource.Resource {
 
 // ruleFileResource is the resource implementation.
 type ruleFileResource struct {
-	client *vmcloudapi.VMCloudAPIClient
+	client   *vmcloudapi.VMCloudAPIClient
+	ruleLint ruleLintConfig
 }
 
 // ruleFileResourceModel maps the resource schema data.
 type ruleFileResourceModel struct {
-	ID           types.String `tfsdk:"id"`
+	ID               types.String         `tfsdk:"id"`
+	DeploymentID     types.String         `tfsdk:"deployment_id"`
+	FileName         types.String         `tfsdk:"file_name"`
+	Content          ruleFileContentValue `tfsdk:"content"`
+	ContentFormat    types.String         `tfsdk:"content_format"`
+	ExtraLabels      types.Map            `tfsdk:"extra_labels"`
+	ExtraAnnotations types.Map            `tfsdk:"extra_annotations"`
+	ConflictPolicy   types.String         `tfsdk:"conflict_policy"`
+	Overwrite        types.Bool           `tfsdk:"overwrite"`
+}
+
+// ruleFileIdentityModel maps the resource identity schema data.
+type ruleFileIdentityModel struct {
 	DeploymentID types.String `tfsdk:"deployment_id"`
 	FileName     types.String `tfsdk:"file_name"`
-	Content      types.String `tfsdk:"content"`
 }
 
 // Metadata returns the resource type name.
@@ -72,9 +91,58 @@ func (r *ruleFileResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			"content": schema.StringAttribute{
-				Description: "YAML content of the alerting or recording rules file.",
+				Description: "Content of the alerting or recording rules file, in the format set by content_format. The content is validated against the vmalert rules format during planning, rule expressions are parsed as MetricsQL queries and alert labels and annotations as vmalert templates. Group names are checked for collisions, and recording rules for dependency cycles and references to undefined recorded metrics, across all rule files of the deployment. Formatting-only differences, such as indentation, quoting or key order, are ignored.",
+				CustomType:  ruleFileContentType{},
 				Required:    true,
 			},
+			"content_format": schema.StringAttribute{
+				Description: "Format of content: 'vmalert_yaml' for a vmalert rules file, 'prometheusrule' for a Prometheus Operator PrometheusRule manifest, " +
+					"or 'json' for a vmalert rules file encoded as JSON (e.g., with jsonencode). Other formats are converted to vmalert YAML before upload; " +
+					"the query_offset of PrometheusRule groups maps to eval_delay, and fields without a vmalert equivalent are rejected. Defaults to 'vmalert_yaml'.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(ruleFileFormatVMAlertYAML),
+			},
+			"extra_labels": schema.MapAttribute{
+				Description: "Labels added to every alerting and recording rule of the file before upload.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"extra_annotations": schema.MapAttribute{
+				Description: "Annotations added to every alerting rule of the file before upload.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"conflict_policy": schema.StringAttribute{
+				Description: "How extra_labels and extra_annotations are merged with labels and annotations a rule already defines: " +
+					"'keep_existing' keeps the value of the rule, or of its group for labels, 'override' replaces it. Defaults to 'keep_existing'.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(conflictPolicyKeepExisting),
+			},
+			"overwrite": schema.BoolAttribute{
+				Description: "Whether to take over a rule file that already exists in the deployment when the resource is created. " +
+					"By default, creation fails if a file with the same name exists. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *ruleFileResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"deployment_id": identityschema.StringAttribute{
+				Description:       "ID of the deployment this rule file belongs to.",
+				RequiredForImport: true,
+			},
+			"file_name": identityschema.StringAttribute{
+				Description:       "Name of the rule file.",
+				RequiredForImport: true,
+			},
 		},
 	}
 }
@@ -85,18 +153,117 @@ func (r *ruleFileResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(*vmcloudapi.VMCloudAPIClient)
+	data, ok := req.ProviderData.(*resourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *vmcloudapi.VMCloudAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.resourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.ruleLint = data.ruleLint
+}
+
+// ValidateConfig parses the rule file content and checks its structure.
+func (r *ruleFileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ruleFileResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ContentFormat.IsNull() && !config.ContentFormat.IsUnknown() && !slices.Contains(ruleFileFormats, config.ContentFormat.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("content_format"),
+			"Invalid Content Format",
+			fmt.Sprintf("Expected one of %s. Got: %q", strings.Join(ruleFileFormats, ", "), config.ContentFormat.ValueString()),
+		)
+		return
+	}
+	if !config.ConflictPolicy.IsNull() && !config.ConflictPolicy.IsUnknown() && !slices.Contains(conflictPolicies, config.ConflictPolicy.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("conflict_policy"),
+			"Invalid Conflict Policy",
+			fmt.Sprintf("Expected one of %s. Got: %q", strings.Join(conflictPolicies, ", "), config.ConflictPolicy.ValueString()),
+		)
+		return
+	}
+	if config.Content.IsNull() || !config.isRenderable() {
+		return
+	}
+
+	file, _, err := config.render()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("content"),
+			invalidRuleFileSummary,
+			"Could not parse rule file content: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(file.validate(path.Root("content"))...)
+}
+
+// ModifyPlan lints the planned content and checks its group names and the dependencies between its
+// recording rules against the other rule files of the deployment, including files not managed by Terraform.
+func (r *ruleFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to analyze on destroy or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan ruleFileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || !plan.isRenderable() {
+		return
+	}
+
+	file, rendered, err := plan.render()
+	if err != nil {
+		return
+	}
+
+	resp.Diagnostics.Append(r.ruleLint.lint(path.Root("content"), "", file)...)
+
+	if plan.DeploymentID.IsUnknown() || plan.FileName.IsUnknown() {
+		return
+	}
+
+	// The planned content replaces the current content of the file, which is left out along with
+	// the previous file name when the file is being renamed.
+	exclude := []string{plan.FileName.ValueString()}
+	if !req.State.Raw.IsNull() {
+		var state ruleFileResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// The other files of the deployment are only read when the planned rules or the file they are
+		// uploaded to change, so that plans without changes do not download every rule file of the deployment.
+		if state.rendersTo(rendered) && state.DeploymentID.Equal(plan.DeploymentID) && state.FileName.Equal(plan.FileName) {
+			return
+		}
+		exclude = append(exclude, state.FileName.ValueString())
+	}
+
+	files, err := deploymentRuleFiles(ctx, r.client, plan.DeploymentID.ValueString(), exclude)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Analyze Deployment Rule Files",
+			"Could not read the rule files of deployment "+plan.DeploymentID.ValueString()+
+				", group names and dependencies between recording rules are not checked across files: "+err.Error(),
+		)
+		return
+	}
+	files[plan.FileName.ValueString()] = file
+
+	resp.Diagnostics.Append(validateGroupNames(path.Root("content"), plan.FileName.ValueString(), files)...)
+	resp.Diagnostics.Append(validateRuleDependencies(path.Root("content"), plan.FileName.ValueString(), files)...)
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -108,13 +275,54 @@ func (r *ruleFileResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	_, content, err := plan.render()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating rule file",
+			"Could not convert rule file content, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Refuse to clobber a rule file that is not managed by this resource
+	exists, err := ruleFileExists(ctx, r.client, plan.DeploymentID.ValueString(), plan.FileName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating rule file",
+			"Could not list existing rule files, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if exists && !plan.Overwrite.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("file_name"),
+			"Rule File Already Exists",
+			ruleFileExistsDetail("victoriametricscloud_rule_file", plan.DeploymentID.ValueString(), plan.FileName.ValueString())+
+				"\n\nAlternatively, set overwrite = true to replace its content.",
+		)
+		return
+	}
+
 	// Create or update the rule file
-	err := r.client.CreateDeploymentRuleFileContent(
-		ctx,
-		plan.DeploymentID.ValueString(),
-		plan.FileName.ValueString(),
-		plan.Content.ValueString(),
-	)
+	if exists {
+		tflog.Debug(ctx, "overwriting existing rule file", map[string]any{
+			"deployment_id": plan.DeploymentID.ValueString(),
+			"file_name":     plan.FileName.ValueString(),
+		})
+		err = r.client.UpdateDeploymentRuleFileContent(
+			ctx,
+			plan.DeploymentID.ValueString(),
+			plan.FileName.ValueString(),
+			content,
+		)
+	} else {
+		err = r.client.CreateDeploymentRuleFileContent(
+			ctx,
+			plan.DeploymentID.ValueString(),
+			plan.FileName.ValueString(),
+			content,
+		)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating rule file",
@@ -133,6 +341,7 @@ func (r *ruleFileResource) Create(ctx context.Context, req resource.CreateReques
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ruleFileIdentityModel{DeploymentID: plan.DeploymentID, FileName: plan.FileName})...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -158,11 +367,21 @@ func (r *ruleFileResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	// Update state with refreshed values
-	state.Content = types.StringValue(content)
+	// Update state with refreshed values, keeping the configured content while the deployment
+	// still holds its rendered form.
+	if state.ContentFormat.IsNull() {
+		state.ContentFormat = types.StringValue(ruleFileFormatVMAlertYAML)
+	}
+	if state.ConflictPolicy.IsNull() {
+		state.ConflictPolicy = types.StringValue(conflictPolicyKeepExisting)
+	}
+	if !state.rendersTo(content) {
+		state.Content = newRuleFileContentValue(content)
+	}
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ruleFileIdentityModel{DeploymentID: state.DeploymentID, FileName: state.FileName})...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -174,12 +393,21 @@ func (r *ruleFileResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	_, content, err := plan.render()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating rule file",
+			"Could not convert rule file content, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
 	// Update the rule file content
-	err := r.client.UpdateDeploymentRuleFileContent(
+	err = r.client.UpdateDeploymentRuleFileContent(
 		ctx,
 		plan.DeploymentID.ValueString(),
 		plan.FileName.ValueString(),
-		plan.Content.ValueString(),
+		content,
 	)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -227,19 +455,151 @@ func (r *ruleFileResource) Delete(ctx context.Context, req resource.DeleteReques
 	})
 }
 
-// ImportState imports the resource state.
+// ImportState imports the resource state from an import identifier or a resource identity.
 func (r *ruleFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Expected format: deployment_id/file_name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+	if req.ID == "" {
+		var identity ruleFileIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		r.importState(ctx, resp, identity.DeploymentID.ValueString(), identity.FileName.ValueString())
+		return
+	}
+
+	// Expected format: deployment_id/file_name or name:deployment_name/file_name.
+	// File names may contain slashes, so the identifier is split at the first one.
+	deploymentRef, fileName, ok := strings.Cut(req.ID, "/")
+	if !ok || deploymentRef == "" || fileName == "" {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
-			fmt.Sprintf("Expected import identifier with format: deployment_id/file_name. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: deployment_id/file_name or name:deployment_name/file_name. Got: %q", req.ID),
 		)
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deployment_id"), parts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("file_name"), parts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	deploymentID, err := resolveDeploymentID(ctx, r.client, deploymentRef)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Could not resolve deployment from import identifier %q: %s", req.ID, err.Error()),
+		)
+		return
+	}
+
+	r.importState(ctx, resp, deploymentID, fileName)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ruleFileIdentityModel{
+		DeploymentID: types.StringValue(deploymentID),
+		FileName:     types.StringValue(fileName),
+	})...)
+}
+
+// importState sets the state of the imported rule file, using the defaults of the optional attributes.
+func (r *ruleFileResource) importState(ctx context.Context, resp *resource.ImportStateResponse, deploymentID, fileName string) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deployment_id"), deploymentID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("file_name"), fileName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s/%s", deploymentID, fileName))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("content_format"), ruleFileFormatVMAlertYAML)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("conflict_policy"), conflictPolicyKeepExisting)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("overwrite"), false)...)
+}
+
+// render converts the configured content to the vmalert rules file uploaded to the deployment,
+// merging the extra labels and annotations into its rules.
+func (m ruleFileResourceModel) render() (ruleFile, string, error) {
+	file, content, err := renderRuleFile(m.ContentFormat.ValueString(), m.Content.ValueString())
+	if err != nil {
+		return ruleFile{}, "", err
+	}
+
+	labels := stringMapElements(m.ExtraLabels)
+	annotations := stringMapElements(m.ExtraAnnotations)
+	if len(labels) == 0 && len(annotations) == 0 {
+		return file, content, nil
+	}
+
+	file.mergeExtras(labels, annotations, m.ConflictPolicy.ValueString() == conflictPolicyOverride)
+	content, err = marshalYAML(file)
+	if err != nil {
+		return ruleFile{}, "", err
+	}
+	return file, content, nil
+}
+
+// rendersTo reports whether the content of the model, converted from its content_format and merged with
+// the extra labels and annotations, holds the same rules as the remote content uploaded to the deployment.
+func (m ruleFileResourceModel) rendersTo(remote string) bool {
+	if m.Content.IsNull() {
+		return false
+	}
+	_, rendered, err := m.render()
+	return err == nil && ruleFilesEqual(remote, rendered)
+}
+
+// isRenderable reports whether every value needed to render the uploaded content is known.
+func (m ruleFileResourceModel) isRenderable() bool {
+	return !m.Content.IsUnknown() && !m.ContentFormat.IsUnknown() && !m.ConflictPolicy.IsUnknown() &&
+		isFullyKnown(m.ExtraLabels) && isFullyKnown(m.ExtraAnnotations)
+}
+
+// stringMapElements returns the known elements of a map of strings.
+func stringMapElements(m types.Map) map[string]string {
+	values := make(map[string]string, len(m.Elements()))
+	for key, value := range m.Elements() {
+		if s, ok := value.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			values[key] = s.ValueString()
+		}
+	}
+	return values
+}
+
+// ruleFileExists reports whether the deployment already has a rule file with the given name.
+func ruleFileExists(ctx context.Context, client *vmcloudapi.VMCloudAPIClient, deploymentID, fileName string) (bool, error) {
+	names, err := client.ListDeploymentRuleFileNames(ctx, deploymentID)
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(names, fileName), nil
+}
+
+// ruleFileExistsDetail explains how to bring an existing rule file under management of the resource type.
+func ruleFileExistsDetail(resourceType, deploymentID, fileName string) string {
+	return fmt.Sprintf(
+		"Rule file %q already exists in deployment %s and may be managed elsewhere. "+
+			"To manage it with this resource, import it first:\n\n"+
+			"  terraform import %s.<name> %s/%s",
+		fileName, deploymentID, resourceType, deploymentID, fileName,
+	)
+}
+
+// deploymentRuleFiles fetches and parses the rule files of the deployment, except the ones named in exclude.
+// Files that cannot be parsed are skipped.
+func deploymentRuleFiles(ctx context.Context, client *vmcloudapi.VMCloudAPIClient, deploymentID string, exclude []string) (map[string]ruleFile, error) {
+	names, err := client.ListDeploymentRuleFileNames(ctx, deploymentID)
+	if err != nil {
+		return nil, err
+	}
+	names = slices.DeleteFunc(names, func(name string) bool {
+		return slices.Contains(exclude, name)
+	})
+
+	contents, err := fetchRuleFiles(ctx, client, deploymentID, names, defaultRuleFilesConcurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]ruleFile, len(contents))
+	for name, content := range contents {
+		file, err := parseRuleFile(content)
+		if err != nil {
+			tflog.Debug(ctx, "skipping rule file that cannot be parsed", map[string]any{
+				"deployment_id": deploymentID,
+				"file_name":     name,
+				"error":         err.Error(),
+			})
+			continue
+		}
+		files[name] = file
+	}
+	return files, nil
 }
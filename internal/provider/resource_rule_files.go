@@ -0,0 +1,632 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+
+	vmcloudapi "github.com/VictoriaMetrics/victoriametrics-cloud-api-go/v1"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &ruleFilesResource{}
+	_ resource.ResourceWithConfigure      = &ruleFilesResource{}
+	_ resource.ResourceWithImportState    = &ruleFilesResource{}
+	_ resource.ResourceWithModifyPlan     = &ruleFilesResource{}
+	_ resource.ResourceWithValidateConfig = &ruleFilesResource{}
+)
+
+// NewRuleFilesResource is a helper function to simplify the provider implementation.
+func NewRuleFilesResource() resource.Resource {
+	return &ruleFilesResource{}
+}
+
+// ruleFilesResource is the resource implementation.
+type ruleFilesResource struct {
+	client   *vmcloudapi.VMCloudAPIClient
+	ruleLint ruleLintConfig
+}
+
+// ruleFilesResourceModel maps the resource schema data.
+type ruleFilesResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	DeploymentID  types.String `tfsdk:"deployment_id"`
+	SourceDir     types.String `tfsdk:"source_dir"`
+	Files         types.Map    `tfsdk:"files"`
+	Authoritative types.Bool   `tfsdk:"authoritative"`
+	FileHashes    types.Map    `tfsdk:"file_hashes"`
+}
+
+// Metadata returns the resource type name.
+func (r *ruleFilesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rule_files"
+}
+
+// Schema defines the schema for the resource.
+func (r *ruleFilesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a set of alerting and recording rule files of a VictoriaMetrics Cloud deployment, " +
+			"read from a local directory or given as a map of file names to content. Only changed files are uploaded.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the rule file set, equal to the deployment ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deployment_id": schema.StringAttribute{
+				Description: "ID of the deployment the rule files belong to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_dir": schema.StringAttribute{
+				Description: "Local directory to read rule files from, or a glob pattern matching them (e.g., 'rules/*.yaml'). " +
+					"When a directory is given, all '.yaml' and '.yml' files in it are used. Files are uploaded under their base name. " +
+					"Exactly one of source_dir or files must be set.",
+				Optional: true,
+			},
+			"files": schema.MapAttribute{
+				Description: "Map of rule file names to their YAML content. Exactly one of source_dir or files must be set.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"authoritative": schema.BoolAttribute{
+				Description: "Whether the set owns all rule files of the deployment. When true, remote rule files that are not part of the set are deleted, " +
+					"and existing files with the same names are taken over on creation. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"file_hashes": schema.MapAttribute{
+				Description: "SHA-256 hashes of the content of every managed rule file, keyed by file name. Remote files that only differ from the local file in formatting keep the hash of the local file.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ruleFilesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*resourceData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.resourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.ruleLint = data.ruleLint
+}
+
+// ValidateConfig checks that exactly one file source is set and validates the structure of every file.
+func (r *ruleFilesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ruleFilesResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.SourceDir.IsNull() == config.Files.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source_dir"),
+			"Invalid Attribute Combination",
+			"Exactly one of source_dir or files must be set.",
+		)
+		return
+	}
+
+	files, known, diags := config.ruleFiles(ctx)
+	resp.Diagnostics.Append(diags...)
+	if !known || resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		p := config.filePath(name)
+		file, err := parseRuleFile(files[name])
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(p, invalidRuleFileSummary, fmt.Sprintf("Could not parse rule file %s: %s", name, err.Error()))
+			continue
+		}
+		for _, d := range file.validate(p) {
+			if d.Severity() == diag.SeverityWarning {
+				resp.Diagnostics.AddAttributeWarning(p, d.Summary(), name+": "+d.Detail())
+			} else {
+				resp.Diagnostics.AddAttributeError(p, d.Summary(), name+": "+d.Detail())
+			}
+		}
+	}
+}
+
+// ModifyPlan reads the rule files, lints their rules and computes their content hashes.
+func (r *ruleFilesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compute on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ruleFilesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	files, known, diags := plan.ruleFiles(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if known && len(files) == 0 && !plan.SourceDir.IsNull() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("source_dir"),
+			"No Rule Files Found",
+			fmt.Sprintf("No rule files match %q. All rule files managed by this resource will be deleted.", plan.SourceDir.ValueString()),
+		)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		if file, err := parseRuleFile(files[name]); err == nil {
+			resp.Diagnostics.Append(r.ruleLint.lint(plan.filePath(name), name+": ", file)...)
+		}
+	}
+
+	plan.FileHashes = types.MapUnknown(types.StringType)
+	if known {
+		plan.FileHashes, diags = types.MapValueFrom(ctx, types.StringType, ruleFileHashes(files))
+		resp.Diagnostics.Append(diags...)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ruleFilesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ruleFilesResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	files, diags := plan.plannedRuleFiles(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteNames, err := r.client.ListDeploymentRuleFileNames(ctx, plan.DeploymentID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating rule files",
+			"Could not list existing rule files, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Refuse to clobber rule files that are not managed by this resource
+	if !plan.Authoritative.ValueBool() {
+		for _, name := range slices.Sorted(maps.Keys(files)) {
+			if slices.Contains(remoteNames, name) {
+				resp.Diagnostics.AddAttributeError(
+					plan.filePath(name),
+					"Rule File Already Exists",
+					fmt.Sprintf(
+						"Rule file %q already exists in deployment %s and may be managed elsewhere. "+
+							"Remove it from the deployment, or set authoritative = true to take over all rule files of the deployment.",
+						name, plan.DeploymentID.ValueString(),
+					),
+				)
+			}
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	hashes, err := r.sync(ctx, plan.DeploymentID.ValueString(), files, nil, remoteNames, plan.Authoritative.ValueBool())
+	plan.ID = types.StringValue(plan.DeploymentID.ValueString())
+	plan.FileHashes, diags = types.MapValueFrom(ctx, types.StringType, hashes)
+	resp.Diagnostics.Append(diags...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating rule files",
+			"Could not upload rule files, unexpected error: "+err.Error(),
+		)
+		// Record the files that were uploaded before the failure
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	tflog.Trace(ctx, "created rule files", map[string]any{
+		"deployment_id": plan.DeploymentID.ValueString(),
+		"files":         len(hashes),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ruleFilesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ruleFilesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteNames, err := r.client.ListDeploymentRuleFileNames(ctx, state.DeploymentID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Rule Files",
+			"Could not list rule files: "+err.Error(),
+		)
+		return
+	}
+
+	var previous map[string]string
+	if !state.FileHashes.IsNull() {
+		resp.Diagnostics.Append(state.FileHashes.ElementsAs(ctx, &previous, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Track every remote file after import or in authoritative mode, so that extra files show up as drift.
+	names := remoteNames
+	if !state.FileHashes.IsNull() && !state.Authoritative.ValueBool() {
+		names = slices.DeleteFunc(slices.Clone(remoteNames), func(name string) bool {
+			_, ok := previous[name]
+			return !ok
+		})
+	}
+
+	// Get refreshed rule file content from API
+	contents, err := fetchRuleFiles(ctx, r.client, state.DeploymentID.ValueString(), names, defaultRuleFilesConcurrency)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Rule Files",
+			err.Error(),
+		)
+		return
+	}
+
+	// Update state with refreshed values, keeping the previous hash of files the deployment holds
+	// in a different serialization of the same rules, so that reformatting is not reported as drift.
+	// Local files that cannot be read are left for the plan to report.
+	local, _, _ := state.ruleFiles(ctx)
+	hashes := ruleFileHashes(contents)
+	for name, content := range contents {
+		if hash, ok := previous[name]; ok && hash != hashes[name] {
+			if localContent, ok := local[name]; ok && ruleFilesEqual(content, localContent) {
+				hashes[name] = hash
+			}
+		}
+	}
+	state.FileHashes, diags = types.MapValueFrom(ctx, types.StringType, hashes)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ruleFilesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ruleFilesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	files, diags := plan.plannedRuleFiles(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var previous map[string]string
+	resp.Diagnostics.Append(state.FileHashes.ElementsAs(ctx, &previous, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteNames, err := r.client.ListDeploymentRuleFileNames(ctx, plan.DeploymentID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating rule files",
+			"Could not list existing rule files, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	hashes, err := r.sync(ctx, plan.DeploymentID.ValueString(), files, previous, remoteNames, plan.Authoritative.ValueBool())
+	plan.FileHashes, diags = types.MapValueFrom(ctx, types.StringType, hashes)
+	resp.Diagnostics.Append(diags...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating rule files",
+			"Could not upload rule files, unexpected error: "+err.Error(),
+		)
+		// Record the files that were uploaded before the failure
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	tflog.Trace(ctx, "updated rule files", map[string]any{
+		"deployment_id": plan.DeploymentID.ValueString(),
+		"files":         len(hashes),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ruleFilesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ruleFilesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var hashes map[string]string
+	resp.Diagnostics.Append(state.FileHashes.ElementsAs(ctx, &hashes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete every managed rule file
+	for _, name := range slices.Sorted(maps.Keys(hashes)) {
+		err := r.client.DeleteDeploymentRuleFile(ctx, state.DeploymentID.ValueString(), name)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting rule files",
+				"Could not delete rule file "+name+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "deleted rule files", map[string]any{
+		"deployment_id": state.DeploymentID.ValueString(),
+		"files":         len(hashes),
+	})
+}
+
+// ImportState imports the resource state.
+func (r *ruleFilesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expected format: deployment_id or name:deployment_name
+	deploymentID, err := resolveDeploymentID(ctx, r.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Could not resolve deployment from import identifier %q: %s", req.ID, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deployment_id"), deploymentID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), deploymentID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("authoritative"), false)...)
+}
+
+// sync uploads new and changed rule files and deletes the ones no longer part of the set.
+// Files whose hash matches previous are left untouched. In authoritative mode, every remote file
+// outside of the set is deleted. It returns the hashes of the files managed after the sync,
+// which reflect the operations that succeeded even if an error is returned.
+func (r *ruleFilesResource) sync(ctx context.Context, deploymentID string, files, previous map[string]string, remoteNames []string, authoritative bool) (map[string]string, error) {
+	hashes := make(map[string]string, len(files))
+	for name, hash := range previous {
+		if slices.Contains(remoteNames, name) {
+			hashes[name] = hash
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		hash := ruleFileHash(files[name])
+		exists := slices.Contains(remoteNames, name)
+		if exists && previous[name] == hash {
+			continue
+		}
+
+		var err error
+		if exists {
+			err = r.client.UpdateDeploymentRuleFileContent(ctx, deploymentID, name, files[name])
+		} else {
+			err = r.client.CreateDeploymentRuleFileContent(ctx, deploymentID, name, files[name])
+		}
+		if err != nil {
+			return hashes, fmt.Errorf("failed to upload rule file %q: %w", name, err)
+		}
+		hashes[name] = hash
+
+		tflog.Debug(ctx, "uploaded rule file", map[string]any{
+			"deployment_id": deploymentID,
+			"file_name":     name,
+		})
+	}
+
+	for _, name := range remoteNames {
+		if _, ok := files[name]; ok {
+			continue
+		}
+		if _, ok := previous[name]; !ok && !authoritative {
+			continue
+		}
+
+		if err := r.client.DeleteDeploymentRuleFile(ctx, deploymentID, name); err != nil {
+			return hashes, fmt.Errorf("failed to delete rule file %q: %w", name, err)
+		}
+		delete(hashes, name)
+
+		tflog.Debug(ctx, "deleted rule file", map[string]any{
+			"deployment_id": deploymentID,
+			"file_name":     name,
+		})
+	}
+
+	return hashes, nil
+}
+
+// ruleFiles returns the content of every rule file of the set, keyed by file name.
+// It reports false if the files cannot be determined yet because of unknown values.
+func (m ruleFilesResourceModel) ruleFiles(ctx context.Context) (map[string]string, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if m.SourceDir.IsUnknown() || m.Files.IsUnknown() {
+		return nil, false, diags
+	}
+
+	if !m.Files.IsNull() {
+		var elements map[string]types.String
+		diags.Append(m.Files.ElementsAs(ctx, &elements, false)...)
+		if diags.HasError() {
+			return nil, false, diags
+		}
+
+		files := make(map[string]string, len(elements))
+		for name, content := range elements {
+			if content.IsUnknown() {
+				return nil, false, diags
+			}
+			files[name] = content.ValueString()
+		}
+		return files, true, diags
+	}
+
+	if m.SourceDir.IsNull() {
+		return map[string]string{}, true, diags
+	}
+
+	files, err := readRuleFilesDir(m.SourceDir.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("source_dir"),
+			"Unable to Read Rule Files",
+			err.Error(),
+		)
+		return nil, false, diags
+	}
+	return files, true, diags
+}
+
+// plannedRuleFiles returns the rule files of the set and checks that they still match the planned hashes.
+func (m ruleFilesResourceModel) plannedRuleFiles(ctx context.Context) (map[string]string, diag.Diagnostics) {
+	files, _, diags := m.ruleFiles(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if m.FileHashes.IsUnknown() {
+		return files, diags
+	}
+
+	var planned map[string]string
+	diags.Append(m.FileHashes.ElementsAs(ctx, &planned, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if !maps.Equal(ruleFileHashes(files), planned) {
+		diags.AddError(
+			"Rule Files Changed",
+			"The rule files changed after the plan was created. Run terraform plan again to review the changes.",
+		)
+		return nil, diags
+	}
+
+	return files, diags
+}
+
+// filePath returns the attribute path to report problems with the named file against.
+func (m ruleFilesResourceModel) filePath(name string) path.Path {
+	if !m.Files.IsNull() {
+		return path.Root("files").AtMapKey(name)
+	}
+	return path.Root("source_dir")
+}
+
+// readRuleFilesDir reads the rule files matching source, keyed by their base name.
+// A directory matches all YAML files directly in it; anything else is treated as a glob pattern.
+func readRuleFilesDir(source string) (map[string]string, error) {
+	patterns := []string{source}
+	if info, err := os.Stat(source); err == nil && info.IsDir() {
+		patterns = []string{filepath.Join(source, "*.yaml"), filepath.Join(source, "*.yml")}
+	}
+
+	files := make(map[string]string)
+	sources := make(map[string]string)
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+		for _, match := range matches {
+			info, err := os.Stat(match)
+			if err != nil {
+				return nil, err
+			}
+			if info.IsDir() {
+				continue
+			}
+
+			name := filepath.Base(match)
+			if previous, ok := sources[name]; ok {
+				return nil, fmt.Errorf("files %s and %s would both be uploaded as %q", previous, match, name)
+			}
+			content, err := os.ReadFile(match)
+			if err != nil {
+				return nil, err
+			}
+			files[name] = string(content)
+			sources[name] = match
+		}
+	}
+
+	return files, nil
+}
+
+// ruleFileHashes returns the content hash of every file, keyed by file name.
+func ruleFileHashes(files map[string]string) map[string]string {
+	hashes := make(map[string]string, len(files))
+	for name, content := range files {
+		hashes[name] = ruleFileHash(content)
+	}
+	return hashes
+}
+
+// ruleFileHash returns the hex-encoded SHA-256 hash of the content.
+func ruleFileHash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
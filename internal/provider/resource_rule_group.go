@@ -0,0 +1,833 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	vmcloudapi "github.com/VictoriaMetrics/victoriametrics-cloud-api-go/v1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &ruleGroupResource{}
+	_ resource.ResourceWithConfigure      = &ruleGroupResource{}
+	_ resource.ResourceWithImportState    = &ruleGroupResource{}
+	_ resource.ResourceWithModifyPlan     = &ruleGroupResource{}
+	_ resource.ResourceWithValidateConfig = &ruleGroupResource{}
+)
+
+// NewRuleGroupResource is a helper function to simplify the provider implementation.
+func NewRuleGroupResource() resource.Resource {
+	return &ruleGroupResource{}
+}
+
+// ruleGroupResource is the resource implementation.
+type ruleGroupResource struct {
+	client   *vmcloudapi.VMCloudAPIClient
+	ruleLint ruleLintConfig
+}
+
+// ruleGroupResourceModel maps the resource schema data.
+type ruleGroupResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	DeploymentID   types.String `tfsdk:"deployment_id"`
+	FileName       types.String `tfsdk:"file_name"`
+	Name           types.String `tfsdk:"name"`
+	Interval       types.String `tfsdk:"interval"`
+	EvalOffset     types.String `tfsdk:"eval_offset"`
+	Concurrency    types.Int64  `tfsdk:"concurrency"`
+	Limit          types.Int64  `tfsdk:"limit"`
+	Labels         types.Map    `tfsdk:"labels"`
+	Params         types.Map    `tfsdk:"params"`
+	Headers        types.List   `tfsdk:"headers"`
+	AlertingRules  types.List   `tfsdk:"alerting_rule"`
+	RecordingRules types.List   `tfsdk:"recording_rule"`
+	Content        types.String `tfsdk:"content"`
+}
+
+// alertingRuleModel maps alerting rule block data.
+type alertingRuleModel struct {
+	Alert         types.String `tfsdk:"alert"`
+	Expr          types.String `tfsdk:"expr"`
+	For           types.String `tfsdk:"for"`
+	KeepFiringFor types.String `tfsdk:"keep_firing_for"`
+	Labels        types.Map    `tfsdk:"labels"`
+	Annotations   types.Map    `tfsdk:"annotations"`
+}
+
+// recordingRuleModel maps recording rule block data.
+type recordingRuleModel struct {
+	Record types.String `tfsdk:"record"`
+	Expr   types.String `tfsdk:"expr"`
+	Labels types.Map    `tfsdk:"labels"`
+}
+
+var alertingRuleAttrTypes = map[string]attr.Type{
+	"alert":           types.StringType,
+	"expr":            types.StringType,
+	"for":             types.StringType,
+	"keep_firing_for": types.StringType,
+	"labels":          types.MapType{ElemType: types.StringType},
+	"annotations":     types.MapType{ElemType: types.StringType},
+}
+
+var recordingRuleAttrTypes = map[string]attr.Type{
+	"record": types.StringType,
+	"expr":   types.StringType,
+	"labels": types.MapType{ElemType: types.StringType},
+}
+
+// Metadata returns the resource type name.
+func (r *ruleGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rule_group"
+}
+
+// Schema defines the schema for the resource.
+func (r *ruleGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single alerting or recording rule group for a VictoriaMetrics Cloud deployment. " +
+			"The group is rendered to vmalert YAML and stored as its own rule file. " +
+			"Recording rules are rendered before alerting rules.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Composite identifier in format 'deployment_id/file_name'.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deployment_id": schema.StringAttribute{
+				Description: "ID of the deployment this rule group belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_name": schema.StringAttribute{
+				Description: "Name of the rule file the group is stored in. Defaults to '<name>.yaml'.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the rule group.",
+				Required:    true,
+			},
+			"interval": schema.StringAttribute{
+				Description: "How often rules in the group are evaluated (e.g., '1m').",
+				Optional:    true,
+			},
+			"eval_offset": schema.StringAttribute{
+				Description: "Offset of the group evaluation within the interval (e.g., '30s').",
+				Optional:    true,
+			},
+			"concurrency": schema.Int64Attribute{
+				Description: "Maximum number of rules in the group evaluated concurrently.",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "Maximum number of alerts or recorded series a single rule of the group may produce.",
+				Optional:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels added to every rule of the group.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"params": schema.MapAttribute{
+				Description: "Extra GET parameters appended to every query of the group.",
+				Optional:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+			},
+			"headers": schema.ListAttribute{
+				Description: "Extra HTTP headers sent with every query of the group, in format 'Header-Name: value'.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"content": schema.StringAttribute{
+				Description: "Rendered YAML content of the rule file.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"alerting_rule": schema.ListNestedBlock{
+				Description: "Alerting rule of the group.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"alert": schema.StringAttribute{
+							Description: "Name of the alert.",
+							Required:    true,
+						},
+						"expr": schema.StringAttribute{
+							Description: "MetricsQL expression to evaluate.",
+							Required:    true,
+						},
+						"for": schema.StringAttribute{
+							Description: "Duration the expression must be true before the alert fires (e.g., '5m').",
+							Optional:    true,
+						},
+						"keep_firing_for": schema.StringAttribute{
+							Description: "Duration the alert keeps firing after the expression stops being true.",
+							Optional:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels added to the alert.",
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+						"annotations": schema.MapAttribute{
+							Description: "Annotations added to the alert.",
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+			"recording_rule": schema.ListNestedBlock{
+				Description: "Recording rule of the group.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"record": schema.StringAttribute{
+							Description: "Name of the recorded metric.",
+							Required:    true,
+						},
+						"expr": schema.StringAttribute{
+							Description: "MetricsQL expression to evaluate.",
+							Required:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels added to the recorded series.",
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ruleGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*resourceData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.resourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.ruleLint = data.ruleLint
+}
+
+// ValidateConfig checks durations and expressions of the group and its rules.
+func (r *ruleGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ruleGroupResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateDurationAttribute(path.Root("interval"), config.Interval)...)
+	resp.Diagnostics.Append(validateDurationAttribute(path.Root("eval_offset"), config.EvalOffset)...)
+	resp.Diagnostics.Append(validateNotEmptyAttribute(path.Root("labels"), config.Labels)...)
+	resp.Diagnostics.Append(validateNotEmptyAttribute(path.Root("params"), config.Params)...)
+	resp.Diagnostics.Append(validateNotEmptyAttribute(path.Root("headers"), config.Headers)...)
+
+	// Range selectors cannot be checked against an interval that is not known yet.
+	interval := defaultGroupInterval
+	if config.Interval.IsUnknown() {
+		interval = ""
+	} else if !config.Interval.IsNull() {
+		interval = config.Interval.ValueString()
+	}
+
+	if !config.AlertingRules.IsNull() && !config.AlertingRules.IsUnknown() {
+		var rules []alertingRuleModel
+		resp.Diagnostics.Append(config.AlertingRules.ElementsAs(ctx, &rules, false)...)
+		for i, rule := range rules {
+			rulePath := path.Root("alerting_rule").AtListIndex(i)
+			resp.Diagnostics.Append(validateExprAttribute(rulePath.AtName("expr"), rule.Expr, interval)...)
+			resp.Diagnostics.Append(validateDurationAttribute(rulePath.AtName("for"), rule.For)...)
+			resp.Diagnostics.Append(validateDurationAttribute(rulePath.AtName("keep_firing_for"), rule.KeepFiringFor)...)
+			resp.Diagnostics.Append(validateTemplateAttributes(ctx, rulePath.AtName("labels"), rule.Labels)...)
+			resp.Diagnostics.Append(validateTemplateAttributes(ctx, rulePath.AtName("annotations"), rule.Annotations)...)
+			resp.Diagnostics.Append(validateNotEmptyAttribute(rulePath.AtName("labels"), rule.Labels)...)
+			resp.Diagnostics.Append(validateNotEmptyAttribute(rulePath.AtName("annotations"), rule.Annotations)...)
+		}
+	}
+
+	if !config.RecordingRules.IsNull() && !config.RecordingRules.IsUnknown() {
+		var rules []recordingRuleModel
+		resp.Diagnostics.Append(config.RecordingRules.ElementsAs(ctx, &rules, false)...)
+		for i, rule := range rules {
+			rulePath := path.Root("recording_rule").AtListIndex(i)
+			resp.Diagnostics.Append(validateExprAttribute(rulePath.AtName("expr"), rule.Expr, interval)...)
+			resp.Diagnostics.Append(validateNotEmptyAttribute(rulePath.AtName("labels"), rule.Labels)...)
+		}
+	}
+}
+
+// ModifyPlan computes the file name, identifier and rendered content of the planned group, and lints its rules.
+func (r *ruleGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compute on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ruleGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config ruleGroupResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.FileName.IsNull() {
+		if plan.Name.IsUnknown() {
+			plan.FileName = types.StringUnknown()
+		} else {
+			plan.FileName = types.StringValue(plan.Name.ValueString() + ".yaml")
+		}
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state ruleGroupResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !plan.FileName.Equal(state.FileName) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("file_name"))
+			plan.ID = types.StringUnknown()
+		}
+	}
+
+	group, known, diags := plan.toRuleGroup(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Content = types.StringUnknown()
+	if known {
+		resp.Diagnostics.Append(r.ruleLint.lint(path.Empty(), "", ruleFile{Groups: []ruleGroup{group}})...)
+
+		content, err := marshalYAML(ruleFile{Groups: []ruleGroup{group}})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error rendering rule group",
+				"Could not render rule group, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		plan.Content = types.StringValue(content)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ruleGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ruleGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Refuse to clobber a rule file that is not managed by this resource
+	exists, err := ruleFileExists(ctx, r.client, plan.DeploymentID.ValueString(), plan.FileName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating rule group",
+			"Could not list existing rule files, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if exists {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("file_name"),
+			"Rule File Already Exists",
+			ruleFileExistsDetail("victoriametricscloud_rule_group", plan.DeploymentID.ValueString(), plan.FileName.ValueString()),
+		)
+		return
+	}
+
+	// Create the rule file holding the group
+	err = r.client.CreateDeploymentRuleFileContent(
+		ctx,
+		plan.DeploymentID.ValueString(),
+		plan.FileName.ValueString(),
+		plan.Content.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating rule group",
+			"Could not create rule group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set the composite ID
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", plan.DeploymentID.ValueString(), plan.FileName.ValueString()))
+
+	tflog.Trace(ctx, "created rule group", map[string]any{
+		"deployment_id": plan.DeploymentID.ValueString(),
+		"file_name":     plan.FileName.ValueString(),
+		"name":          plan.Name.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ruleGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ruleGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed rule file content from API
+	content, err := r.client.GetDeploymentRuleFileContent(
+		ctx,
+		state.DeploymentID.ValueString(),
+		state.FileName.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Rule Group",
+			"Could not read rule file "+state.FileName.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	file, err := parseRuleFile(content)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Rule Group",
+			"Could not parse rule file "+state.FileName.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if len(file.Groups) != 1 {
+		resp.Diagnostics.AddError(
+			"Error Reading Rule Group",
+			fmt.Sprintf("Expected rule file %s to contain exactly one group, got %d. Use the rule_file resource to manage files with several groups.", state.FileName.ValueString(), len(file.Groups)),
+		)
+		return
+	}
+
+	// Update state with refreshed values
+	resp.Diagnostics.Append(state.fromRuleGroup(ctx, file.Groups[0])...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Store the content as rendered from the parsed group to match the planned content.
+	rendered, err := marshalYAML(ruleFile{Groups: file.Groups})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Rule Group",
+			"Could not render rule group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	state.Content = types.StringValue(rendered)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ruleGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ruleGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the rule file holding the group
+	err := r.client.UpdateDeploymentRuleFileContent(
+		ctx,
+		plan.DeploymentID.ValueString(),
+		plan.FileName.ValueString(),
+		plan.Content.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating rule group",
+			"Could not update rule group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "updated rule group", map[string]any{
+		"deployment_id": plan.DeploymentID.ValueString(),
+		"file_name":     plan.FileName.ValueString(),
+		"name":          plan.Name.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ruleGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ruleGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete the rule file holding the group
+	err := r.client.DeleteDeploymentRuleFile(
+		ctx,
+		state.DeploymentID.ValueString(),
+		state.FileName.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting rule group",
+			"Could not delete rule group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted rule group", map[string]any{
+		"deployment_id": state.DeploymentID.ValueString(),
+		"file_name":     state.FileName.ValueString(),
+	})
+}
+
+// ImportState imports the resource state.
+func (r *ruleGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expected format: deployment_id/file_name or name:deployment_name/file_name.
+	// File names may contain slashes, so the identifier is split at the first one.
+	deploymentRef, fileName, ok := strings.Cut(req.ID, "/")
+	if !ok || deploymentRef == "" || fileName == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import identifier with format: deployment_id/file_name or name:deployment_name/file_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	deploymentID, err := resolveDeploymentID(ctx, r.client, deploymentRef)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Could not resolve deployment from import identifier %q: %s", req.ID, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deployment_id"), deploymentID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("file_name"), fileName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s/%s", deploymentID, fileName))...)
+}
+
+// toRuleGroup converts the model to a vmalert rule group.
+// It reports false if any value needed to render the group is not known yet.
+func (m ruleGroupResourceModel) toRuleGroup(ctx context.Context) (ruleGroup, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := []attr.Value{m.Name, m.Interval, m.EvalOffset, m.Concurrency, m.Limit, m.Labels, m.Params, m.Headers, m.AlertingRules, m.RecordingRules}
+	for _, value := range values {
+		if !isFullyKnown(value) {
+			return ruleGroup{}, false, diags
+		}
+	}
+
+	group := ruleGroup{
+		Name:       m.Name.ValueString(),
+		Interval:   m.Interval.ValueString(),
+		EvalOffset: m.EvalOffset.ValueString(),
+		Rules:      []rule{},
+	}
+	if !m.Concurrency.IsNull() {
+		concurrency := int(m.Concurrency.ValueInt64())
+		group.Concurrency = &concurrency
+	}
+	if !m.Limit.IsNull() {
+		limit := int(m.Limit.ValueInt64())
+		group.Limit = &limit
+	}
+	diags.Append(m.Labels.ElementsAs(ctx, &group.Labels, false)...)
+	diags.Append(m.Params.ElementsAs(ctx, &group.Params, false)...)
+	diags.Append(m.Headers.ElementsAs(ctx, &group.Headers, false)...)
+
+	var recordingRules []recordingRuleModel
+	diags.Append(m.RecordingRules.ElementsAs(ctx, &recordingRules, false)...)
+	for _, recordingRule := range recordingRules {
+		r := rule{
+			Record: recordingRule.Record.ValueString(),
+			Expr:   recordingRule.Expr.ValueString(),
+		}
+		diags.Append(recordingRule.Labels.ElementsAs(ctx, &r.Labels, false)...)
+		group.Rules = append(group.Rules, r)
+	}
+
+	var alertingRules []alertingRuleModel
+	diags.Append(m.AlertingRules.ElementsAs(ctx, &alertingRules, false)...)
+	for _, alertingRule := range alertingRules {
+		r := rule{
+			Alert:         alertingRule.Alert.ValueString(),
+			Expr:          alertingRule.Expr.ValueString(),
+			For:           alertingRule.For.ValueString(),
+			KeepFiringFor: alertingRule.KeepFiringFor.ValueString(),
+		}
+		diags.Append(alertingRule.Labels.ElementsAs(ctx, &r.Labels, false)...)
+		diags.Append(alertingRule.Annotations.ElementsAs(ctx, &r.Annotations, false)...)
+		group.Rules = append(group.Rules, r)
+	}
+
+	return group, true, diags
+}
+
+// fromRuleGroup populates the model from a vmalert rule group.
+// Groups using fields the schema does not model are rejected, since the next update would drop them.
+func (m *ruleGroupResourceModel) fromRuleGroup(ctx context.Context, group ruleGroup) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if fields := unmodeledRuleGroupFields(group); len(fields) > 0 {
+		diags.AddError(
+			"Unsupported Rule Group Fields",
+			fmt.Sprintf("Rule group %q uses fields the rule_group resource does not manage: %s. Use the rule_file resource to manage this group without losing them.", group.Name, strings.Join(fields, ", ")),
+		)
+		return diags
+	}
+
+	m.Name = types.StringValue(group.Name)
+	m.Interval = stringValueOrNull(group.Interval)
+	m.EvalOffset = stringValueOrNull(group.EvalOffset)
+	m.Concurrency = types.Int64Null()
+	if group.Concurrency != nil {
+		m.Concurrency = types.Int64Value(int64(*group.Concurrency))
+	}
+	m.Limit = types.Int64Null()
+	if group.Limit != nil {
+		m.Limit = types.Int64Value(int64(*group.Limit))
+	}
+	m.Labels = stringMapOrNull(ctx, group.Labels, &diags)
+	m.Params = types.MapNull(types.ListType{ElemType: types.StringType})
+	if len(group.Params) > 0 {
+		params, d := types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, group.Params)
+		diags.Append(d...)
+		m.Params = params
+	}
+	m.Headers = types.ListNull(types.StringType)
+	if len(group.Headers) > 0 {
+		headers, d := types.ListValueFrom(ctx, types.StringType, group.Headers)
+		diags.Append(d...)
+		m.Headers = headers
+	}
+
+	alertingRules := []alertingRuleModel{}
+	recordingRules := []recordingRuleModel{}
+	for _, r := range group.Rules {
+		if r.Alert != "" {
+			alertingRules = append(alertingRules, alertingRuleModel{
+				Alert:         types.StringValue(r.Alert),
+				Expr:          types.StringValue(r.Expr),
+				For:           stringValueOrNull(r.For),
+				KeepFiringFor: stringValueOrNull(r.KeepFiringFor),
+				Labels:        stringMapOrNull(ctx, r.Labels, &diags),
+				Annotations:   stringMapOrNull(ctx, r.Annotations, &diags),
+			})
+			continue
+		}
+		recordingRules = append(recordingRules, recordingRuleModel{
+			Record: types.StringValue(r.Record),
+			Expr:   types.StringValue(r.Expr),
+			Labels: stringMapOrNull(ctx, r.Labels, &diags),
+		})
+	}
+
+	alertingList, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: alertingRuleAttrTypes}, alertingRules)
+	diags.Append(d...)
+	m.AlertingRules = alertingList
+
+	recordingList, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: recordingRuleAttrTypes}, recordingRules)
+	diags.Append(d...)
+	m.RecordingRules = recordingList
+
+	return diags
+}
+
+// unmodeledRuleGroupFields returns the fields set in group or its rules that the rule_group schema does not model.
+// A "prometheus" type is the vmalert default and is not reported.
+func unmodeledRuleGroupFields(group ruleGroup) []string {
+	var fields []string
+	if !isMetricsQLGroup(group.Type) {
+		fields = append(fields, "type")
+	}
+	if group.EvalDelay != "" {
+		fields = append(fields, "eval_delay")
+	}
+	if group.EvalAlignment != nil {
+		fields = append(fields, "eval_alignment")
+	}
+	if len(group.NotifierHeaders) > 0 {
+		fields = append(fields, "notifier_headers")
+	}
+	for _, r := range group.Rules {
+		if r.Debug && !slices.Contains(fields, "debug") {
+			fields = append(fields, "debug")
+		}
+		if r.UpdateEntriesLimit != nil && !slices.Contains(fields, "update_entries_limit") {
+			fields = append(fields, "update_entries_limit")
+		}
+	}
+	return fields
+}
+
+// validateNotEmptyAttribute reports a known map or list attribute without elements. Empty values are
+// left out of the rendered rule file and read back as null, which would show a difference on every plan.
+func validateNotEmptyAttribute(p path.Path, value attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() {
+		return diags
+	}
+
+	var count int
+	switch v := value.(type) {
+	case types.Map:
+		count = len(v.Elements())
+	case types.List:
+		count = len(v.Elements())
+	}
+	if count == 0 {
+		diags.AddAttributeError(p, "Empty Attribute Value", "Omit the attribute instead of setting it to an empty value, which the rule file cannot distinguish from an unset one.")
+	}
+	return diags
+}
+
+// validateDurationAttribute reports a known string attribute that is not a valid duration.
+func validateDurationAttribute(p path.Path, value types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() || promDurationRe.MatchString(value.ValueString()) {
+		return diags
+	}
+	diags.AddAttributeError(p, "Invalid Duration", fmt.Sprintf("Expected a duration such as '30s', '5m' or '1h30m'. Got: %q", value.ValueString()))
+	return diags
+}
+
+// validateExprAttribute reports a known expression attribute that is empty or not a valid MetricsQL query,
+// and warns about likely mistakes in it.
+func validateExprAttribute(p path.Path, value types.String, interval string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() {
+		return diags
+	}
+	if strings.TrimSpace(value.ValueString()) == "" {
+		diags.AddAttributeError(p, "Invalid Expression", "Expression cannot be empty.")
+		return diags
+	}
+
+	warnings, err := checkExpr(value.ValueString(), interval)
+	if err != nil {
+		diags.AddAttributeError(p, "Invalid Expression", "Could not parse MetricsQL expression: "+err.Error())
+	}
+	for _, warning := range warnings {
+		diags.AddAttributeWarning(p, suspiciousExprSummary, warning)
+	}
+	return diags
+}
+
+// validateTemplateAttributes reports every known element of a map attribute that is not a valid vmalert template.
+func validateTemplateAttributes(ctx context.Context, p path.Path, value types.Map) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() {
+		return diags
+	}
+
+	var templates map[string]types.String
+	diags.Append(value.ElementsAs(ctx, &templates, false)...)
+	for _, key := range slices.Sorted(maps.Keys(templates)) {
+		text := templates[key]
+		if text.IsNull() || text.IsUnknown() {
+			continue
+		}
+		if err := checkTemplate(key, text.ValueString()); err != nil {
+			diags.AddAttributeError(p.AtMapKey(key), "Invalid Template", "Could not parse template: "+err.Error())
+		}
+	}
+	return diags
+}
+
+// isFullyKnown reports whether the value and all of its nested values are known.
+func isFullyKnown(value attr.Value) bool {
+	if value.IsUnknown() {
+		return false
+	}
+	if value.IsNull() {
+		return true
+	}
+
+	var elements []attr.Value
+	switch v := value.(type) {
+	case types.List:
+		elements = v.Elements()
+	case types.Map:
+		for _, element := range v.Elements() {
+			elements = append(elements, element)
+		}
+	case types.Object:
+		for _, element := range v.Attributes() {
+			elements = append(elements, element)
+		}
+	}
+	for _, element := range elements {
+		if !isFullyKnown(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// stringValueOrNull returns a null string for empty values.
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// stringMapOrNull converts a string map to a map value, returning a null map for empty maps.
+func stringMapOrNull(ctx context.Context, values map[string]string, diags *diag.Diagnostics) types.Map {
+	if len(values) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	m, d := types.MapValueFrom(ctx, types.StringType, values)
+	diags.Append(d...)
+	return m
+}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = ruleFileContentType{}
+	_ basetypes.StringValuableWithSemanticEquals = ruleFileContentValue{}
+)
+
+// ruleFileContentType is a string type holding vmalert rules file content.
+type ruleFileContentType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t ruleFileContentType) String() string {
+	return "ruleFileContentType"
+}
+
+// Equal returns true if the given type is equivalent.
+func (t ruleFileContentType) Equal(o attr.Type) bool {
+	other, ok := o.(ruleFileContentType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t ruleFileContentType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return ruleFileContentValue{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t ruleFileContentType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return ruleFileContentValue{StringValue: stringValue}, nil
+}
+
+// ValueType returns the Value type.
+func (t ruleFileContentType) ValueType(_ context.Context) attr.Value {
+	return ruleFileContentValue{}
+}
+
+// ruleFileContentValue is a vmalert rules file content value.
+// Values are semantically equal when both documents parse to the same rule groups,
+// regardless of formatting, key order or quoting.
+type ruleFileContentValue struct {
+	basetypes.StringValue
+}
+
+// newRuleFileContentValue creates a known rules file content value.
+func newRuleFileContentValue(content string) ruleFileContentValue {
+	return ruleFileContentValue{StringValue: basetypes.NewStringValue(content)}
+}
+
+// Type returns the value type.
+func (v ruleFileContentValue) Type(_ context.Context) attr.Type {
+	return ruleFileContentType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v ruleFileContentValue) Equal(o attr.Value) bool {
+	other, ok := o.(ruleFileContentValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if both documents parse to the same rule groups.
+func (v ruleFileContentValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(ruleFileContentValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return ruleFilesEqual(v.ValueString(), newValue.ValueString()), diags
+}
+
+// ruleFilesEqual reports whether both contents parse to the same rule groups. Each content is decoded
+// in its own format, so a PrometheusRule manifest equals the vmalert rules file it renders to.
+// Contents that cannot be parsed are only equal when they are identical.
+func ruleFilesEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	canonicalA, err := canonicalRuleFile(a)
+	if err != nil {
+		return false
+	}
+	canonicalB, err := canonicalRuleFile(b)
+	if err != nil {
+		return false
+	}
+
+	return canonicalA == canonicalB
+}
+
+// canonicalRuleFile renders content in its detected format and serializes the rules back with normalized
+// formatting and key order. Leading and trailing whitespace of string fields is trimmed, so that a block
+// scalar such as "expr: |" with its trailing newline equals the same value written as a plain scalar.
+func canonicalRuleFile(content string) (string, error) {
+	file, _, err := renderRuleFile(detectRuleFileFormat(content), content)
+	if err != nil {
+		return "", err
+	}
+
+	for gi := range file.Groups {
+		g := &file.Groups[gi]
+		g.Name = strings.TrimSpace(g.Name)
+		g.Labels = trimSpaceMap(g.Labels)
+		for _, values := range g.Params {
+			for i := range values {
+				values[i] = strings.TrimSpace(values[i])
+			}
+		}
+		for i := range g.Headers {
+			g.Headers[i] = strings.TrimSpace(g.Headers[i])
+		}
+		for i := range g.NotifierHeaders {
+			g.NotifierHeaders[i] = strings.TrimSpace(g.NotifierHeaders[i])
+		}
+		for ri := range g.Rules {
+			r := &g.Rules[ri]
+			r.Record = strings.TrimSpace(r.Record)
+			r.Alert = strings.TrimSpace(r.Alert)
+			r.Expr = strings.TrimSpace(r.Expr)
+			r.Labels = trimSpaceMap(r.Labels)
+			r.Annotations = trimSpaceMap(r.Annotations)
+		}
+	}
+
+	return marshalYAML(file)
+}
+
+// trimSpaceMap trims leading and trailing whitespace of every value of m in place.
+func trimSpaceMap(m map[string]string) map[string]string {
+	for key, value := range m {
+		m[key] = strings.TrimSpace(value)
+	}
+	return m
+}
@@ -0,0 +1,295 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"gopkg.in/yaml.v3"
+)
+
+// ruleFile maps the vmalert rules file format.
+type ruleFile struct {
+	Groups []ruleGroup `yaml:"groups"`
+}
+
+// ruleGroup maps a vmalert rule group.
+type ruleGroup struct {
+	Name            string              `yaml:"name"`
+	Type            string              `yaml:"type,omitempty"`
+	Interval        string              `yaml:"interval,omitempty"`
+	EvalOffset      string              `yaml:"eval_offset,omitempty"`
+	EvalDelay       string              `yaml:"eval_delay,omitempty"`
+	EvalAlignment   *bool               `yaml:"eval_alignment,omitempty"`
+	Limit           *int                `yaml:"limit,omitempty"`
+	Concurrency     *int                `yaml:"concurrency,omitempty"`
+	Labels          map[string]string   `yaml:"labels,omitempty"`
+	Params          map[string][]string `yaml:"params,omitempty"`
+	Headers         []string            `yaml:"headers,omitempty"`
+	NotifierHeaders []string            `yaml:"notifier_headers,omitempty"`
+	Rules           []rule              `yaml:"rules"`
+
+	// line is the line of the group in the source document, 0 if unknown.
+	line int
+}
+
+// rule maps a vmalert alerting or recording rule.
+type rule struct {
+	Record             string            `yaml:"record,omitempty"`
+	Alert              string            `yaml:"alert,omitempty"`
+	Expr               string            `yaml:"expr"`
+	For                string            `yaml:"for,omitempty"`
+	KeepFiringFor      string            `yaml:"keep_firing_for,omitempty"`
+	Labels             map[string]string `yaml:"labels,omitempty"`
+	Annotations        map[string]string `yaml:"annotations,omitempty"`
+	Debug              bool              `yaml:"debug,omitempty"`
+	UpdateEntriesLimit *int              `yaml:"update_entries_limit,omitempty"`
+
+	// line is the line of the rule in the source document, 0 if unknown.
+	line int
+}
+
+// ruleCount returns the number of rules across all groups of the file.
+func (f ruleFile) ruleCount() int {
+	count := 0
+	for _, group := range f.Groups {
+		count += len(group.Rules)
+	}
+	return count
+}
+
+// Policies for merging extra labels and annotations into rules.
+const (
+	conflictPolicyKeepExisting = "keep_existing"
+	conflictPolicyOverride     = "override"
+)
+
+// conflictPolicies lists the supported policies for merging extra labels and annotations.
+var conflictPolicies = []string{conflictPolicyKeepExisting, conflictPolicyOverride}
+
+// mergeExtras adds labels to every rule and annotations to every alerting rule of the file.
+// Keys a rule already defines are only replaced when override is set. vmalert applies the labels
+// of a group to all of its rules, so the keys of group labels count as defined by every rule of the group.
+func (f *ruleFile) mergeExtras(labels, annotations map[string]string, override bool) {
+	merge := func(dst, src, inherited map[string]string) map[string]string {
+		if len(src) == 0 {
+			return dst
+		}
+		merged := make(map[string]string, len(dst)+len(src))
+		maps.Copy(merged, dst)
+		for key, value := range src {
+			_, defined := merged[key]
+			_, inherits := inherited[key]
+			if override || !defined && !inherits {
+				merged[key] = value
+			}
+		}
+		return merged
+	}
+
+	for gi := range f.Groups {
+		g := &f.Groups[gi]
+		for ri := range g.Rules {
+			r := &g.Rules[ri]
+			r.Labels = merge(r.Labels, labels, g.Labels)
+			if r.Alert != "" {
+				r.Annotations = merge(r.Annotations, annotations, nil)
+			}
+		}
+	}
+}
+
+// name returns the alert or record name of the rule.
+func (r rule) name() string {
+	if r.Alert != "" {
+		return r.Alert
+	}
+	return r.Record
+}
+
+// invalidRuleFileSummary is the summary of diagnostics reported for malformed rules files.
+const invalidRuleFileSummary = "Invalid Rule File"
+
+// promDurationRe matches Prometheus-style durations such as "30s", "1h30m" or "1.5d".
+var promDurationRe = regexp.MustCompile(`^(\d+(\.\d+)?(ms|s|m|h|d|w|y))+$`)
+
+// parseRuleFile parses content in the vmalert rules file format.
+// Unknown fields are rejected, as vmalert does when loading the file.
+func parseRuleFile(content string) (ruleFile, error) {
+	var file ruleFile
+	decoder := yaml.NewDecoder(strings.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return ruleFile{}, err
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &root); err != nil {
+		return ruleFile{}, err
+	}
+	file.setLines(&root)
+
+	return file, nil
+}
+
+// setLines records the source line of every group and rule from the parsed YAML document.
+func (f *ruleFile) setLines(root *yaml.Node) {
+	groups := yamlMappingValue(root, "groups")
+	if groups == nil || groups.Kind != yaml.SequenceNode {
+		return
+	}
+	for i, groupNode := range groups.Content {
+		if i >= len(f.Groups) {
+			return
+		}
+		f.Groups[i].line = groupNode.Line
+
+		rules := yamlMappingValue(groupNode, "rules")
+		if rules == nil || rules.Kind != yaml.SequenceNode {
+			continue
+		}
+		for j, ruleNode := range rules.Content {
+			if j >= len(f.Groups[i].Rules) {
+				break
+			}
+			f.Groups[i].Rules[j].line = ruleNode.Line
+		}
+	}
+}
+
+// yamlMappingValue returns the value of key in the mapping node, unwrapping documents.
+func yamlMappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// groupLocation describes the position of a group for diagnostics.
+func groupLocation(groupIndex int, group ruleGroup) string {
+	location := fmt.Sprintf("group[%d] %q", groupIndex, group.Name)
+	if group.line > 0 {
+		location += fmt.Sprintf(" (line %d)", group.line)
+	}
+	return location
+}
+
+// ruleLocation describes the position of a rule for diagnostics.
+func ruleLocation(groupIndex int, group ruleGroup, ruleIndex int, r rule) string {
+	location := fmt.Sprintf("group[%d] %q, rule[%d]", groupIndex, group.Name, ruleIndex)
+	if name := r.name(); name != "" {
+		location += fmt.Sprintf(" %q", name)
+	}
+	if r.line > 0 {
+		location += fmt.Sprintf(" (line %d)", r.line)
+	}
+	return location
+}
+
+// validate checks the structure of the rules file, the MetricsQL expressions of its rules and
+// the templates of its alerts, and reports problems against the attribute at p.
+func (f ruleFile) validate(p path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	groupNames := make(map[string]int, len(f.Groups))
+	for gi, group := range f.Groups {
+		if group.Name == "" {
+			diags.AddAttributeError(p, invalidRuleFileSummary, groupLocation(gi, group)+": group name cannot be empty.")
+		} else if previous, ok := groupNames[group.Name]; ok {
+			diags.AddAttributeError(p, invalidRuleFileSummary, fmt.Sprintf(
+				"%s: group name duplicates %s. Group names must be unique within a file.",
+				groupLocation(gi, group), groupLocation(previous, f.Groups[previous]),
+			))
+		} else {
+			groupNames[group.Name] = gi
+		}
+
+		diags.Append(validateDurations(p, groupLocation(gi, group), [][2]string{
+			{"interval", group.Interval},
+			{"eval_offset", group.EvalOffset},
+			{"eval_delay", group.EvalDelay},
+		})...)
+
+		interval := group.Interval
+		if interval == "" {
+			interval = defaultGroupInterval
+		}
+
+		for ri, r := range group.Rules {
+			location := ruleLocation(gi, group, ri, r)
+			switch {
+			case r.Record == "" && r.Alert == "":
+				diags.AddAttributeError(p, invalidRuleFileSummary, location+": rule must define either record or alert.")
+			case r.Record != "" && r.Alert != "":
+				diags.AddAttributeError(p, invalidRuleFileSummary, location+": rule cannot define both record and alert.")
+			}
+			if strings.TrimSpace(r.Expr) == "" {
+				diags.AddAttributeError(p, invalidRuleFileSummary, location+": expr cannot be empty.")
+			} else if isMetricsQLGroup(group.Type) {
+				warnings, err := checkExpr(r.Expr, interval)
+				if err != nil {
+					diags.AddAttributeError(p, invalidRuleFileSummary, location+": could not parse expr: "+err.Error())
+				}
+				for _, warning := range warnings {
+					diags.AddAttributeWarning(p, suspiciousExprSummary, location+": "+warning)
+				}
+			}
+			diags.Append(validateDurations(p, location, [][2]string{
+				{"for", r.For},
+				{"keep_firing_for", r.KeepFiringFor},
+			})...)
+			if r.Alert != "" {
+				diags.Append(validateTemplates(p, location, "label", r.Labels)...)
+				diags.Append(validateTemplates(p, location, "annotation", r.Annotations)...)
+			}
+		}
+	}
+
+	return diags
+}
+
+// validateGroupNames reports every group of the file named fileName whose name is also used by a group
+// of another file of files, keyed by file name, against the attribute at p.
+func validateGroupNames(p path.Path, fileName string, files map[string]ruleFile) diag.Diagnostics {
+	var diags diag.Diagnostics
+	others := slices.Sorted(maps.Keys(files))
+	for gi, group := range files[fileName].Groups {
+		for _, other := range others {
+			if other == fileName {
+				continue
+			}
+			if slices.ContainsFunc(files[other].Groups, func(g ruleGroup) bool { return g.Name == group.Name }) {
+				diags.AddAttributeError(p, "Duplicate Rule Group", fmt.Sprintf(
+					"%s of rule file %s is also defined in rule file %s. Group names must be unique across the rule files of a deployment.",
+					groupLocation(gi, group), fileName, other,
+				))
+			}
+		}
+	}
+	return diags
+}
+
+// validateDurations reports every non-empty field value that is not a valid duration.
+func validateDurations(p path.Path, location string, fields [][2]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, field := range fields {
+		if field[1] != "" && !promDurationRe.MatchString(field[1]) {
+			diags.AddAttributeError(p, invalidRuleFileSummary, fmt.Sprintf("%s: %s %q is not a valid duration.", location, field[0], field[1]))
+		}
+	}
+	return diags
+}
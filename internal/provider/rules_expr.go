@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/VictoriaMetrics/metricsql"
+)
+
+// defaultGroupInterval is the evaluation interval vmalert uses for groups without an explicit interval.
+const defaultGroupInterval = "1m"
+
+// suspiciousExprSummary is the summary of warnings reported for expressions that parse but look wrong.
+const suspiciousExprSummary = "Suspicious Rule Expression"
+
+// counterFuncs lists the rollup functions that are meant to be applied to counters.
+var counterFuncs = []string{"rate", "irate", "increase", "increase_pure", "increase_prometheus"}
+
+// counterSuffixes lists the metric name suffixes conventionally used by counters.
+var counterSuffixes = []string{"_total", "_count", "_sum", "_bucket"}
+
+// checkExpr parses expr with the MetricsQL parser and returns warnings about likely mistakes.
+// Range selectors are compared against interval, unless it is empty.
+func checkExpr(expr, interval string) ([]string, error) {
+	parsed, err := metricsql.Parse(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	var intervalMs int64
+	if interval != "" {
+		intervalMs, _ = metricsql.DurationValue(interval, 0)
+	}
+
+	var warnings []string
+	metricsql.VisitAll(parsed, func(e metricsql.Expr) {
+		switch e := e.(type) {
+		case *metricsql.FuncExpr:
+			if !slices.Contains(counterFuncs, strings.ToLower(e.Name)) || len(e.Args) == 0 {
+				return
+			}
+			arg := e.Args[0]
+			if rollup, ok := arg.(*metricsql.RollupExpr); ok {
+				arg = rollup.Expr
+			}
+			me, ok := arg.(*metricsql.MetricExpr)
+			if !ok {
+				return
+			}
+			if name := metricName(me); name != "" && !isCounterName(name) {
+				warnings = append(warnings, fmt.Sprintf(
+					"%s() is applied to %q, which does not look like a counter. Counter names usually end with %s.",
+					strings.ToLower(e.Name), name, strings.Join(counterSuffixes, ", "),
+				))
+			}
+		case *metricsql.RollupExpr:
+			if intervalMs <= 0 || e.Window == nil || e.Step != nil || e.InheritStep {
+				return
+			}
+			if _, ok := e.Expr.(*metricsql.MetricExpr); !ok {
+				return
+			}
+			if window := e.Window.Duration(intervalMs); window < intervalMs {
+				warnings = append(warnings, fmt.Sprintf(
+					"range selector %s is shorter than the group interval %s, so samples between evaluations are ignored.",
+					e.AppendString(nil), interval,
+				))
+			}
+		}
+	})
+
+	return warnings, nil
+}
+
+// metricName returns the metric name selected by me, or an empty string if it does not select a single name.
+func metricName(me *metricsql.MetricExpr) string {
+	name := ""
+	for _, filters := range me.LabelFilterss {
+		if len(filters) == 0 || filters[0].Label != "__name__" || filters[0].IsNegative || filters[0].IsRegexp {
+			return ""
+		}
+		if name != "" && name != filters[0].Value {
+			return ""
+		}
+		name = filters[0].Value
+	}
+	return name
+}
+
+// isCounterName reports whether the metric name follows the naming conventions of counters.
+func isCounterName(name string) bool {
+	for _, suffix := range counterSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isMetricsQLGroup reports whether the expressions of a group with the given type are MetricsQL queries.
+func isMetricsQLGroup(groupType string) bool {
+	return groupType == "" || groupType == "prometheus"
+}
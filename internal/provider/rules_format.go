@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Supported formats of rule file content.
+const (
+	ruleFileFormatVMAlertYAML    = "vmalert_yaml"
+	ruleFileFormatPrometheusRule = "prometheusrule"
+	ruleFileFormatJSON           = "json"
+)
+
+// ruleFileFormats lists the supported formats of rule file content.
+var ruleFileFormats = []string{ruleFileFormatVMAlertYAML, ruleFileFormatPrometheusRule, ruleFileFormatJSON}
+
+// prometheusRule maps the Prometheus Operator PrometheusRule custom resource.
+type prometheusRule struct {
+	APIVersion string             `yaml:"apiVersion"`
+	Kind       string             `yaml:"kind"`
+	Metadata   map[string]any     `yaml:"metadata"`
+	Spec       prometheusRuleSpec `yaml:"spec"`
+}
+
+// prometheusRuleSpec maps the spec of a PrometheusRule.
+type prometheusRuleSpec struct {
+	Groups []prometheusRuleGroup `yaml:"groups"`
+}
+
+// prometheusRuleGroup maps a PrometheusRule rule group.
+type prometheusRuleGroup struct {
+	Name                    string               `yaml:"name"`
+	Labels                  map[string]string    `yaml:"labels"`
+	Interval                string               `yaml:"interval"`
+	QueryOffset             string               `yaml:"query_offset"`
+	Limit                   *int                 `yaml:"limit"`
+	PartialResponseStrategy string               `yaml:"partial_response_strategy"`
+	Rules                   []prometheusRuleRule `yaml:"rules"`
+}
+
+// prometheusRuleRule maps a PrometheusRule alerting or recording rule.
+type prometheusRuleRule struct {
+	Record        string            `yaml:"record"`
+	Alert         string            `yaml:"alert"`
+	Expr          string            `yaml:"expr"`
+	For           string            `yaml:"for"`
+	KeepFiringFor string            `yaml:"keep_firing_for"`
+	Labels        map[string]string `yaml:"labels"`
+	Annotations   map[string]string `yaml:"annotations"`
+}
+
+// renderRuleFile decodes content in the given format. It returns the parsed rules file along with
+// the vmalert rules document to upload, which is content itself for the vmalert_yaml format.
+func renderRuleFile(format, content string) (ruleFile, string, error) {
+	switch format {
+	case "", ruleFileFormatVMAlertYAML:
+		file, err := parseRuleFile(content)
+		return file, content, err
+	case ruleFileFormatJSON:
+		// JSON documents are valid YAML, so they are parsed as vmalert rules files.
+		file, err := parseRuleFile(content)
+		if err != nil {
+			return ruleFile{}, "", err
+		}
+		rendered, err := marshalYAML(file)
+		return file, rendered, err
+	case ruleFileFormatPrometheusRule:
+		file, err := parsePrometheusRule(content)
+		if err != nil {
+			return ruleFile{}, "", err
+		}
+		rendered, err := marshalYAML(file)
+		return file, rendered, err
+	default:
+		return ruleFile{}, "", fmt.Errorf("unsupported content format %q", format)
+	}
+}
+
+// detectRuleFileFormat returns the format of content: prometheusrule for PrometheusRule manifests and
+// vmalert_yaml otherwise. JSON documents are valid YAML rendered the same way, so they are reported as vmalert_yaml.
+func detectRuleFileFormat(content string) string {
+	var header struct {
+		Kind string `yaml:"kind"`
+	}
+	if err := yaml.Unmarshal([]byte(content), &header); err == nil && header.Kind == "PrometheusRule" {
+		return ruleFileFormatPrometheusRule
+	}
+	return ruleFileFormatVMAlertYAML
+}
+
+// parsePrometheusRule converts a PrometheusRule manifest to a vmalert rules file.
+// Fields without a vmalert equivalent are rejected.
+func parsePrometheusRule(content string) (ruleFile, error) {
+	var manifest prometheusRule
+	decoder := yaml.NewDecoder(strings.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&manifest); err != nil && !errors.Is(err, io.EOF) {
+		return ruleFile{}, fmt.Errorf("invalid PrometheusRule manifest: %w", err)
+	}
+	if manifest.Kind != "PrometheusRule" {
+		return ruleFile{}, fmt.Errorf("expected a manifest of kind PrometheusRule, got %q", manifest.Kind)
+	}
+
+	file := ruleFile{Groups: make([]ruleGroup, 0, len(manifest.Spec.Groups))}
+	for _, g := range manifest.Spec.Groups {
+		if g.PartialResponseStrategy != "" {
+			return ruleFile{}, fmt.Errorf("group %q: partial_response_strategy has no vmalert equivalent", g.Name)
+		}
+
+		group := ruleGroup{
+			Name:      g.Name,
+			Interval:  g.Interval,
+			EvalDelay: g.QueryOffset,
+			Limit:     g.Limit,
+			Labels:    g.Labels,
+			Rules:     make([]rule, 0, len(g.Rules)),
+		}
+		for _, r := range g.Rules {
+			group.Rules = append(group.Rules, rule{
+				Record:        r.Record,
+				Alert:         r.Alert,
+				Expr:          r.Expr,
+				For:           r.For,
+				KeepFiringFor: r.KeepFiringFor,
+				Labels:        r.Labels,
+				Annotations:   r.Annotations,
+			})
+		}
+		file.Groups = append(file.Groups, group)
+	}
+
+	return file, nil
+}
@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/VictoriaMetrics/metricsql"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// recordingRuleRef identifies a recording rule in a set of rule files.
+type recordingRuleRef struct {
+	fileName string
+	group    string
+}
+
+// String returns a human readable description of the rule location.
+func (r recordingRuleRef) String() string {
+	return fmt.Sprintf("%s, group %q", r.fileName, r.group)
+}
+
+// ruleDependencyGraph links recorded metrics to the metrics their expressions select.
+type ruleDependencyGraph struct {
+	// definitions lists the rules producing every recorded metric.
+	definitions map[string][]recordingRuleRef
+	// dependencies lists the metrics selected by the rules producing every recorded metric.
+	dependencies map[string][]string
+}
+
+// newRuleDependencyGraph builds the dependency graph of the recording rules of files, keyed by file name.
+// Rules with expressions that cannot be parsed are ignored.
+func newRuleDependencyGraph(files map[string]ruleFile) ruleDependencyGraph {
+	g := ruleDependencyGraph{
+		definitions:  make(map[string][]recordingRuleRef),
+		dependencies: make(map[string][]string),
+	}
+	for _, fileName := range slices.Sorted(maps.Keys(files)) {
+		for _, group := range files[fileName].Groups {
+			if !isMetricsQLGroup(group.Type) {
+				continue
+			}
+			for _, r := range group.Rules {
+				if r.Record == "" {
+					continue
+				}
+				g.definitions[r.Record] = append(g.definitions[r.Record], recordingRuleRef{fileName: fileName, group: group.Name})
+				for _, name := range exprMetricNames(r.Expr) {
+					if !slices.Contains(g.dependencies[r.Record], name) {
+						g.dependencies[r.Record] = append(g.dependencies[r.Record], name)
+					}
+				}
+			}
+		}
+	}
+	return g
+}
+
+// edges returns the recorded metrics the rules producing name depend on.
+func (g ruleDependencyGraph) edges(name string) []string {
+	var deps []string
+	for _, dep := range g.dependencies[name] {
+		if _, ok := g.definitions[dep]; ok {
+			deps = append(deps, dep)
+		}
+	}
+	return deps
+}
+
+// cyclicComponents returns the strongly connected components of recorded metrics that contain a
+// dependency cycle, found with Tarjan's algorithm. Every metric of a component lies on a cycle,
+// and the names of every component are sorted.
+func (g ruleDependencyGraph) cyclicComponents() [][]string {
+	index := make(map[string]int, len(g.definitions))
+	lowLink := make(map[string]int, len(g.definitions))
+	onStack := make(map[string]bool)
+	var stack []string
+	var components [][]string
+
+	var visit func(name string)
+	visit = func(name string) {
+		index[name] = len(index)
+		lowLink[name] = index[name]
+		stack = append(stack, name)
+		onStack[name] = true
+
+		for _, dep := range g.edges(name) {
+			if _, ok := index[dep]; !ok {
+				visit(dep)
+				lowLink[name] = min(lowLink[name], lowLink[dep])
+			} else if onStack[dep] {
+				lowLink[name] = min(lowLink[name], index[dep])
+			}
+		}
+		if lowLink[name] != index[name] {
+			return
+		}
+
+		i := slices.Index(stack, name)
+		component := slices.Clone(stack[i:])
+		stack = stack[:i]
+		for _, member := range component {
+			onStack[member] = false
+		}
+		if len(component) > 1 || slices.Contains(g.edges(name), name) {
+			slices.Sort(component)
+			components = append(components, component)
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(g.definitions)) {
+		if _, ok := index[name]; !ok {
+			visit(name)
+		}
+	}
+	slices.SortFunc(components, func(a, b []string) int {
+		return strings.Compare(a[0], b[0])
+	})
+	return components
+}
+
+// cycleThrough returns the shortest dependency cycle from start back to start within its component,
+// starting at start. start must belong to component, a result of cyclicComponents.
+func (g ruleDependencyGraph) cycleThrough(start string, component []string) []string {
+	previous := map[string]string{}
+	queue := []string{start}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		for _, dep := range g.edges(name) {
+			if !slices.Contains(component, dep) {
+				continue
+			}
+			if dep == start {
+				cycle := []string{name}
+				for cycle[0] != start {
+					cycle = append([]string{previous[cycle[0]]}, cycle...)
+				}
+				return cycle
+			}
+			if _, ok := previous[dep]; !ok {
+				previous[dep] = name
+				queue = append(queue, dep)
+			}
+		}
+	}
+	return []string{start}
+}
+
+// validateRuleDependencies analyzes the recording rule dependencies between the file named fileName and
+// the other rule files of the deployment. Cycles involving the file are reported as errors, and references
+// from the file to recorded metrics that no file defines as warnings, against the attribute at p.
+func validateRuleDependencies(p path.Path, fileName string, files map[string]ruleFile) diag.Diagnostics {
+	var diags diag.Diagnostics
+	g := newRuleDependencyGraph(files)
+
+	// A cycle through the file is reported for every component the file takes part in, starting at
+	// the first recorded metric of the component the file defines.
+	for _, component := range g.cyclicComponents() {
+		start := ""
+		for _, name := range component {
+			if slices.ContainsFunc(g.definitions[name], func(ref recordingRuleRef) bool { return ref.fileName == fileName }) {
+				start = name
+				break
+			}
+		}
+		if start == "" {
+			continue
+		}
+
+		cycle := g.cycleThrough(start, component)
+		var steps []string
+		for _, name := range cycle {
+			steps = append(steps, fmt.Sprintf("%q (%s)", name, g.definitions[name][0]))
+		}
+		steps = append(steps, fmt.Sprintf("%q", cycle[0]))
+		diags.AddAttributeError(p, "Recording Rule Dependency Cycle", fmt.Sprintf(
+			"Recording rules depend on each other in a cycle: %s. vmalert cannot produce these series.",
+			strings.Join(steps, " -> "),
+		))
+	}
+
+	for gi, group := range files[fileName].Groups {
+		if !isMetricsQLGroup(group.Type) {
+			continue
+		}
+		for ri, r := range group.Rules {
+			for _, name := range exprMetricNames(r.Expr) {
+				if _, ok := g.definitions[name]; ok || !isRecordedMetricName(name) {
+					continue
+				}
+				diags.AddAttributeWarning(p, "Undefined Recorded Metric", fmt.Sprintf(
+					"%s: expr references recorded metric %q, which no rule file of the deployment defines.",
+					ruleLocation(gi, group, ri, r), name,
+				))
+			}
+		}
+	}
+
+	return diags
+}
+
+// exprMetricNames returns the metric names selected by expr, in order of appearance.
+// It returns nil if expr cannot be parsed.
+func exprMetricNames(expr string) []string {
+	parsed, err := metricsql.Parse(expr)
+	if err != nil {
+		return nil
+	}
+
+	var names []string
+	metricsql.VisitAll(parsed, func(e metricsql.Expr) {
+		if me, ok := e.(*metricsql.MetricExpr); ok {
+			if name := metricName(me); name != "" && !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	})
+	return names
+}
+
+// isRecordedMetricName reports whether the metric name follows the level:metric:operation convention
+// of recording rules. Colons are reserved for recorded metrics, so scraped metrics never contain them.
+func isRecordedMetricName(name string) bool {
+	return strings.Contains(name, ":")
+}
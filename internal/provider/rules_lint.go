@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Severities of rule lint checks.
+const (
+	ruleLintOff     = "off"
+	ruleLintWarning = "warning"
+	ruleLintError   = "error"
+)
+
+// ruleLintSeverities lists the supported severities of rule lint checks.
+var ruleLintSeverities = []string{ruleLintOff, ruleLintWarning, ruleLintError}
+
+// ruleLintSummary is the summary of diagnostics reported by rule lint checks.
+const ruleLintSummary = "Rule Lint Violation"
+
+// recordingRuleNameRe matches recording rule names following the level:metric:operation convention.
+var recordingRuleNameRe = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*:[a-zA-Z_][a-zA-Z0-9_]*:[a-zA-Z0-9_]+$`)
+
+// ruleLintConfig holds the severity of every rule lint check.
+type ruleLintConfig struct {
+	MissingFor          string
+	MissingAnnotations  string
+	MissingSeverity     string
+	RecordingRuleNaming string
+}
+
+// lint checks the rules of the file against the configured checks and reports violations
+// against the attribute at p. Every detail is prefixed with prefix.
+func (c ruleLintConfig) lint(p path.Path, prefix string, file ruleFile) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	report := func(severity, check, location, message string) {
+		detail := fmt.Sprintf("%s%s: %s (rule_lint.%s)", prefix, location, message, check)
+		switch severity {
+		case ruleLintWarning:
+			diags.AddAttributeWarning(p, ruleLintSummary, detail)
+		case ruleLintError:
+			diags.AddAttributeError(p, ruleLintSummary, detail)
+		}
+	}
+
+	for gi, group := range file.Groups {
+		for ri, r := range group.Rules {
+			location := ruleLocation(gi, group, ri, r)
+
+			if r.Record != "" {
+				if !recordingRuleNameRe.MatchString(r.Record) {
+					report(c.RecordingRuleNaming, "recording_rule_naming", location,
+						"recording rule name does not follow the level:metric:operation convention.")
+				}
+				continue
+			}
+			if r.Alert == "" {
+				continue
+			}
+
+			if r.For == "" {
+				report(c.MissingFor, "missing_for", location,
+					"alert has no for duration, so it fires on the first evaluation that matches.")
+			}
+
+			var missing []string
+			for _, key := range []string{"summary", "description"} {
+				if _, ok := r.Annotations[key]; !ok {
+					missing = append(missing, key)
+				}
+			}
+			if len(missing) > 0 {
+				report(c.MissingAnnotations, "missing_annotations", location,
+					"alert has no "+strings.Join(missing, " or ")+" annotation.")
+			}
+
+			_, hasSeverity := r.Labels["severity"]
+			_, groupHasSeverity := group.Labels["severity"]
+			if !hasSeverity && !groupHasSeverity {
+				report(c.MissingSeverity, "missing_severity", location,
+					"alert has no severity label.")
+			}
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// templateHeader declares the variables vmalert makes available to alert label and annotation templates.
+const templateHeader = `{{- $labels := .Labels -}}{{- $value := .Value -}}{{- $expr := .Expr -}}` +
+	`{{- $externalLabels := .ExternalLabels -}}{{- $externalURL := .ExternalURL -}}` +
+	`{{- $alertID := .AlertID -}}{{- $groupID := .GroupID -}}{{- $activeAt := .ActiveAt -}}{{- $for := .For -}}`
+
+// templateFuncNames lists the functions vmalert adds to alert label and annotation templates.
+var templateFuncNames = []string{
+	"args", "crlfEscape", "externalURL", "first", "htmlEscape", "humanize", "humanize1024",
+	"humanizeDuration", "humanizePercentage", "humanizeTimestamp", "jsonEscape", "label", "match",
+	"now", "parseDuration", "parseDurationTime", "pathEscape", "pathPrefix", "query", "queryEscape",
+	"quotesEscape", "reReplaceAll", "safeHtml", "sortByLabel", "stripDomain", "stripPort", "strvalue",
+	"title", "toLower", "toTime", "toUpper", "value",
+}
+
+// templateFuncs stubs the vmalert template functions, so that templates can be parsed without being executed.
+var templateFuncs = func() template.FuncMap {
+	funcs := make(template.FuncMap, len(templateFuncNames))
+	for _, name := range templateFuncNames {
+		funcs[name] = func(...any) (any, error) { return nil, nil }
+	}
+	return funcs
+}()
+
+// checkTemplate parses text as a vmalert alert template.
+func checkTemplate(name, text string) error {
+	_, err := template.New(name).Funcs(templateFuncs).Parse(templateHeader + text)
+	return err
+}
+
+// validateTemplates reports every label or annotation of an alerting rule that is not a valid template.
+func validateTemplates(p path.Path, location, kind string, templates map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, key := range slices.Sorted(maps.Keys(templates)) {
+		if err := checkTemplate(key, templates[key]); err != nil {
+			diags.AddAttributeError(p, invalidRuleFileSummary, fmt.Sprintf("%s: %s %q is not a valid template: %s", location, kind, key, err.Error()))
+		}
+	}
+	return diags
+}